@@ -0,0 +1,227 @@
+package grpcexec
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"strings"
+	"time"
+
+	"spiderjob/lib/plugin"
+	"spiderjob/lib/plugin/types"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/dynamicpb"
+)
+
+const (
+	// defaultTimeout is used when the job doesn't set a call timeout.
+	defaultTimeout = 30 * time.Second
+)
+
+var (
+	// ErrNoAddress is returned when the job doesn't define the server address.
+	ErrNoAddress = errors.New("grpc: unspecified address for job")
+	// ErrNoMethod is returned when the job doesn't define the method to call.
+	ErrNoMethod = errors.New("grpc: unspecified method for job")
+)
+
+// GRPC is the built-in executor calling a unary gRPC method. The method
+// is resolved using server reflection so no compiled stubs are needed.
+//
+// Accepted executor_config keys:
+//
+//	address:                  host:port of the server, required
+//	method:                   full method name, like "package.Service/Method", required
+//	body:                     JSON request message, defaults to an empty message
+//	timeout:                  call timeout, like "10s", defaults to 30s
+//	expect_codes:             comma separated list of successful status codes, like "OK,NOT_FOUND", defaults to OK
+//	tls:                      "true" to connect using TLS
+//	tls_ca_file:              CA certificates file used to verify the server
+//	tls_insecure_skip_verify: "true" to skip the server certificate verification
+type GRPC struct{}
+
+// New returns a new gRPC executor.
+func New() plugin.Executor {
+	return &GRPC{}
+}
+
+// Execute calls the method, the output contains the JSON response
+// or the status returned by the server.
+func (g *GRPC) Execute(ctx context.Context, args *types.ExecuteRequest, cb plugin.StatusHelper) (*types.ExecuteResponse, error) {
+	res, err := g.ExecuteImpl(ctx, args)
+	if err != nil {
+		res.Error = err.Error()
+	}
+	return res, nil
+}
+
+// ExecuteImpl resolves the method, calls it and checks the returned status code.
+func (g *GRPC) ExecuteImpl(ctx context.Context, args *types.ExecuteRequest) (*types.ExecuteResponse, error) {
+	res := &types.ExecuteResponse{}
+	config := args.Config
+	if config["address"] == "" {
+		return res, ErrNoAddress
+	}
+	service, method, err := splitMethod(config["method"])
+	if err != nil {
+		return res, err
+	}
+
+	timeout := defaultTimeout
+	if config["timeout"] != "" {
+		d, err := time.ParseDuration(config["timeout"])
+		if err != nil {
+			return res, fmt.Errorf("grpc: invalid timeout %q: %s", config["timeout"], err)
+		}
+		timeout = d
+	}
+
+	expectCodes, err := parseCodes(config["expect_codes"])
+	if err != nil {
+		return res, err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	dialOpt, err := transportCredentials(config)
+	if err != nil {
+		return res, err
+	}
+	conn, err := grpc.DialContext(ctx, config["address"], dialOpt, grpc.WithBlock())
+	if err != nil {
+		return res, fmt.Errorf("grpc: error dialing %s: %s", config["address"], err)
+	}
+	defer conn.Close()
+
+	md, err := resolveMethod(ctx, conn, service, method)
+	if err != nil {
+		return res, err
+	}
+
+	req := dynamicpb.NewMessage(md.Input())
+	if body := config["body"]; body != "" {
+		if err := protojson.Unmarshal([]byte(body), req); err != nil {
+			return res, fmt.Errorf("grpc: invalid body for %s: %s", md.Input().FullName(), err)
+		}
+	}
+	resp := dynamicpb.NewMessage(md.Output())
+
+	err = conn.Invoke(ctx, fmt.Sprintf("/%s/%s", service, method), req, resp)
+	st := status.Convert(err)
+
+	res.Results = map[string]string{
+		"status_code": st.Code().String(),
+	}
+	if err == nil {
+		res.Output, err = protojson.MarshalOptions{Multiline: true}.Marshal(resp)
+		if err != nil {
+			return res, fmt.Errorf("grpc: error encoding response: %s", err)
+		}
+	} else {
+		res.Output = []byte(fmt.Sprintf("%s: %s", st.Code(), st.Message()))
+	}
+
+	if !expectedCode(st.Code(), expectCodes) {
+		return res, fmt.Errorf("grpc: unexpected status code %s", st.Code())
+	}
+	return res, nil
+}
+
+// splitMethod splits "package.Service/Method" or "package.Service.Method".
+func splitMethod(fullMethod string) (string, string, error) {
+	fullMethod = strings.TrimPrefix(fullMethod, "/")
+	if fullMethod == "" {
+		return "", "", ErrNoMethod
+	}
+	i := strings.LastIndex(fullMethod, "/")
+	if i < 0 {
+		i = strings.LastIndex(fullMethod, ".")
+	}
+	if i <= 0 || i == len(fullMethod)-1 {
+		return "", "", fmt.Errorf("grpc: invalid method %q, use \"package.Service/Method\"", fullMethod)
+	}
+	return fullMethod[:i], fullMethod[i+1:], nil
+}
+
+// resolveMethod finds the descriptor of a unary method using server reflection.
+func resolveMethod(ctx context.Context, conn *grpc.ClientConn, service, method string) (protoreflect.MethodDescriptor, error) {
+	files, err := newReflectionResolver(ctx, conn).filesForSymbol(service)
+	if err != nil {
+		return nil, err
+	}
+
+	d, err := files.FindDescriptorByName(protoreflect.FullName(service))
+	if err != nil {
+		return nil, fmt.Errorf("grpc: service %s not found: %s", service, err)
+	}
+	sd, ok := d.(protoreflect.ServiceDescriptor)
+	if !ok {
+		return nil, fmt.Errorf("grpc: %s is not a service", service)
+	}
+	md := sd.Methods().ByName(protoreflect.Name(method))
+	if md == nil {
+		return nil, fmt.Errorf("grpc: method %s not found in service %s", method, service)
+	}
+	if md.IsStreamingClient() || md.IsStreamingServer() {
+		return nil, fmt.Errorf("grpc: method %s/%s is streaming, only unary methods are supported", service, method)
+	}
+	return md, nil
+}
+
+func transportCredentials(config map[string]string) (grpc.DialOption, error) {
+	if config["tls"] != "true" {
+		return grpc.WithInsecure(), nil
+	}
+
+	tlsConfig := &tls.Config{
+		InsecureSkipVerify: config["tls_insecure_skip_verify"] == "true",
+	}
+	if config["tls_ca_file"] != "" {
+		ca, err := ioutil.ReadFile(config["tls_ca_file"])
+		if err != nil {
+			return nil, fmt.Errorf("grpc: error reading CA file: %s", err)
+		}
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(ca) {
+			return nil, fmt.Errorf("grpc: no valid certificates found in %s", config["tls_ca_file"])
+		}
+		tlsConfig.RootCAs = pool
+	}
+	return grpc.WithTransportCredentials(credentials.NewTLS(tlsConfig)), nil
+}
+
+// parseCodes parses a comma separated list of status code names.
+func parseCodes(names string) ([]codes.Code, error) {
+	if names == "" {
+		return []codes.Code{codes.OK}, nil
+	}
+
+	var res []codes.Code
+	for _, n := range strings.Split(names, ",") {
+		var c codes.Code
+		if err := c.UnmarshalJSON([]byte(`"` + strings.ToUpper(strings.TrimSpace(n)) + `"`)); err != nil {
+			return nil, fmt.Errorf("grpc: invalid expect_codes %q", names)
+		}
+		res = append(res, c)
+	}
+	return res, nil
+}
+
+func expectedCode(code codes.Code, expected []codes.Code) bool {
+	for _, c := range expected {
+		if c == code {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,154 @@
+package grpcexec
+
+import (
+	"context"
+	"net"
+	"strings"
+	"testing"
+
+	"spiderjob/lib/plugin/types"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/reflection"
+)
+
+// testServer starts an in-process server with the health service and
+// server reflection, it returns its address.
+func testServer(t *testing.T) string {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	s := grpc.NewServer()
+	hs := health.NewServer()
+	hs.SetServingStatus("down", healthpb.HealthCheckResponse_NOT_SERVING)
+	healthpb.RegisterHealthServer(s, hs)
+	reflection.Register(s)
+
+	go s.Serve(l)
+	t.Cleanup(s.Stop)
+	return l.Addr().String()
+}
+
+func TestExecute(t *testing.T) {
+	addr := testServer(t)
+
+	tests := []struct {
+		name       string
+		config     map[string]string
+		wantError  string
+		wantOutput string
+		wantCode   string
+	}{
+		{
+			name:       "success",
+			config:     map[string]string{"method": "grpc.health.v1.Health/Check"},
+			wantOutput: `"SERVING"`,
+			wantCode:   "OK",
+		},
+		{
+			name:       "request body",
+			config:     map[string]string{"method": "grpc.health.v1.Health.Check", "body": `{"service": "down"}`},
+			wantOutput: `"NOT_SERVING"`,
+			wantCode:   "OK",
+		},
+		{
+			name:       "unexpected status code",
+			config:     map[string]string{"method": "grpc.health.v1.Health/Check", "body": `{"service": "missing"}`},
+			wantError:  "grpc: unexpected status code NotFound",
+			wantOutput: "NotFound: unknown service",
+			wantCode:   "NotFound",
+		},
+		{
+			name: "expected status code",
+			config: map[string]string{
+				"method":       "grpc.health.v1.Health/Check",
+				"body":         `{"service": "missing"}`,
+				"expect_codes": "OK, NOT_FOUND",
+			},
+			wantCode: "NotFound",
+		},
+		{
+			name:      "invalid expected codes",
+			config:    map[string]string{"method": "grpc.health.v1.Health/Check", "expect_codes": "OK,MAYBE"},
+			wantError: "grpc: invalid expect_codes",
+		},
+		{
+			name:      "invalid body",
+			config:    map[string]string{"method": "grpc.health.v1.Health/Check", "body": `{"unknown": 1}`},
+			wantError: "grpc: invalid body for grpc.health.v1.HealthCheckRequest",
+		},
+		{
+			name:      "unknown method",
+			config:    map[string]string{"method": "grpc.health.v1.Health/Missing"},
+			wantError: "grpc: method Missing not found in service grpc.health.v1.Health",
+		},
+		{
+			name:      "unknown service",
+			config:    map[string]string{"method": "missing.Service/Method"},
+			wantError: "grpc: server reflection error",
+		},
+		{
+			name:      "streaming method",
+			config:    map[string]string{"method": "grpc.health.v1.Health/Watch"},
+			wantError: "grpc: method grpc.health.v1.Health/Watch is streaming",
+		},
+		{
+			name:      "invalid method",
+			config:    map[string]string{"method": "Check"},
+			wantError: `grpc: invalid method "Check"`,
+		},
+		{
+			name:      "no method",
+			config:    map[string]string{},
+			wantError: ErrNoMethod.Error(),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			config := map[string]string{"address": addr, "timeout": "5s"}
+			for k, v := range tt.config {
+				config[k] = v
+			}
+
+			g := &GRPC{}
+			res, err := g.Execute(context.Background(), &types.ExecuteRequest{
+				JobName: "test",
+				Config:  config,
+			}, nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if tt.wantError == "" && res.Error != "" {
+				t.Fatalf("got error %q", res.Error)
+			}
+			if !strings.Contains(res.Error, tt.wantError) {
+				t.Fatalf("got error %q, want %q", res.Error, tt.wantError)
+			}
+			if !strings.Contains(string(res.Output), tt.wantOutput) {
+				t.Fatalf("output %q doesn't contain %q", res.Output, tt.wantOutput)
+			}
+			if res.Results["status_code"] != tt.wantCode {
+				t.Fatalf("got status code %q, want %q", res.Results["status_code"], tt.wantCode)
+			}
+		})
+	}
+}
+
+func TestExecuteNoAddress(t *testing.T) {
+	g := &GRPC{}
+	res, err := g.Execute(context.Background(), &types.ExecuteRequest{
+		JobName: "test",
+		Config:  map[string]string{"method": "grpc.health.v1.Health/Check"},
+	}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.Error != ErrNoAddress.Error() {
+		t.Fatalf("got error %q, want %q", res.Error, ErrNoAddress)
+	}
+}
@@ -0,0 +1,127 @@
+package grpcexec
+
+import (
+	"context"
+	"fmt"
+
+	"google.golang.org/grpc"
+	rpb "google.golang.org/grpc/reflection/grpc_reflection_v1alpha"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"google.golang.org/protobuf/types/descriptorpb"
+)
+
+// reflectionResolver builds file descriptors from the
+// ones returned by the server reflection service.
+type reflectionResolver struct {
+	ctx    context.Context
+	client rpb.ServerReflectionClient
+	stream rpb.ServerReflection_ServerReflectionInfoClient
+
+	files  *protoregistry.Files
+	protos map[string]*descriptorpb.FileDescriptorProto
+}
+
+func newReflectionResolver(ctx context.Context, conn *grpc.ClientConn) *reflectionResolver {
+	return &reflectionResolver{
+		ctx:    ctx,
+		client: rpb.NewServerReflectionClient(conn),
+		files:  &protoregistry.Files{},
+		protos: make(map[string]*descriptorpb.FileDescriptorProto),
+	}
+}
+
+// filesForSymbol returns a registry with the file defining
+// the given symbol and all its dependencies.
+func (r *reflectionResolver) filesForSymbol(symbol string) (*protoregistry.Files, error) {
+	stream, err := r.client.ServerReflectionInfo(r.ctx)
+	if err != nil {
+		return nil, fmt.Errorf("grpc: error calling server reflection: %s", err)
+	}
+	defer stream.CloseSend()
+	r.stream = stream
+
+	names, err := r.request(&rpb.ServerReflectionRequest{
+		MessageRequest: &rpb.ServerReflectionRequest_FileContainingSymbol{
+			FileContainingSymbol: symbol,
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// The first file is the one defining the symbol
+	if _, err := r.file(names[0]); err != nil {
+		return nil, err
+	}
+	return r.files, nil
+}
+
+// file builds the descriptor of the given file after its dependencies,
+// fetching from the server the ones that were not received yet.
+func (r *reflectionResolver) file(name string) (protoreflect.FileDescriptor, error) {
+	if fd, err := r.files.FindFileByPath(name); err == nil {
+		return fd, nil
+	}
+
+	fdp, ok := r.protos[name]
+	if !ok {
+		// Well known types are usually linked in the binary
+		if fd, err := protoregistry.GlobalFiles.FindFileByPath(name); err == nil {
+			return fd, r.files.RegisterFile(fd)
+		}
+		if _, err := r.request(&rpb.ServerReflectionRequest{
+			MessageRequest: &rpb.ServerReflectionRequest_FileByFilename{
+				FileByFilename: name,
+			},
+		}); err != nil {
+			return nil, err
+		}
+		if fdp, ok = r.protos[name]; !ok {
+			return nil, fmt.Errorf("grpc: server reflection didn't return file %s", name)
+		}
+	}
+
+	for _, dep := range fdp.GetDependency() {
+		if _, err := r.file(dep); err != nil {
+			return nil, err
+		}
+	}
+
+	fd, err := protodesc.NewFile(fdp, r.files)
+	if err != nil {
+		return nil, fmt.Errorf("grpc: invalid descriptor for file %s: %s", name, err)
+	}
+	return fd, r.files.RegisterFile(fd)
+}
+
+// request sends a reflection request and stores the returned
+// file descriptors, it returns their names in the received order.
+func (r *reflectionResolver) request(req *rpb.ServerReflectionRequest) ([]string, error) {
+	if err := r.stream.Send(req); err != nil {
+		return nil, fmt.Errorf("grpc: error calling server reflection: %s", err)
+	}
+	resp, err := r.stream.Recv()
+	if err != nil {
+		return nil, fmt.Errorf("grpc: error calling server reflection: %s", err)
+	}
+	if e := resp.GetErrorResponse(); e != nil {
+		return nil, fmt.Errorf("grpc: server reflection error: %s", e.GetErrorMessage())
+	}
+
+	var names []string
+	for _, b := range resp.GetFileDescriptorResponse().GetFileDescriptorProto() {
+		fdp := &descriptorpb.FileDescriptorProto{}
+		if err := proto.Unmarshal(b, fdp); err != nil {
+			return nil, fmt.Errorf("grpc: invalid descriptor returned by server reflection: %s", err)
+		}
+		r.protos[fdp.GetName()] = fdp
+		names = append(names, fdp.GetName())
+	}
+	if len(names) == 0 {
+		return nil, fmt.Errorf("grpc: server reflection returned no files")
+	}
+	return names, nil
+}
@@ -0,0 +1,255 @@
+package httpexec
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"spiderjob/lib/plugin"
+	"spiderjob/lib/plugin/types"
+)
+
+const (
+	// defaultTimeout is used when the job doesn't set a request timeout.
+	defaultTimeout = 30 * time.Second
+	// maxBodySize limits how much of the response body is read.
+	maxBodySize = 1 << 20
+	// maxOutputBody limits how much of the response body is kept as output.
+	maxOutputBody = 64 * 1024
+)
+
+var (
+	// ErrNoURL is returned when the job doesn't define the url to call.
+	ErrNoURL = errors.New("http: unspecified url for job")
+)
+
+// HTTP is the built-in executor calling an HTTP endpoint.
+//
+// Accepted executor_config keys:
+//
+//	url:                      url to call, required
+//	method:                   request method, defaults to GET
+//	headers:                  JSON list of headers, like ["Content-Type: application/json"]
+//	body:                     request body
+//	timeout:                  request timeout, like "10s", defaults to 30s
+//	expect_codes:             comma separated list of successful status codes, defaults to any 2xx
+//	expect_body:              regular expression the response body must match to succeed
+//	tls_cert_file:            client certificate file
+//	tls_key_file:             client certificate key file
+//	tls_ca_file:              CA certificates file used to verify the server
+//	tls_insecure_skip_verify: "true" to skip the server certificate verification
+type HTTP struct{}
+
+// New returns a new HTTP executor.
+func New() plugin.Executor {
+	return &HTTP{}
+}
+
+// Execute calls the endpoint, the output contains the status line and the
+// response body, truncated if too long.
+func (s *HTTP) Execute(ctx context.Context, args *types.ExecuteRequest, cb plugin.StatusHelper) (*types.ExecuteResponse, error) {
+	res, err := s.ExecuteImpl(ctx, args)
+	if err != nil {
+		res.Error = err.Error()
+	}
+	return res, nil
+}
+
+// ExecuteImpl does the request and checks the response against the expectations.
+func (s *HTTP) ExecuteImpl(ctx context.Context, args *types.ExecuteRequest) (*types.ExecuteResponse, error) {
+	res := &types.ExecuteResponse{}
+	config := args.Config
+	if config["url"] == "" {
+		return res, ErrNoURL
+	}
+
+	timeout := defaultTimeout
+	if config["timeout"] != "" {
+		d, err := time.ParseDuration(config["timeout"])
+		if err != nil {
+			return res, fmt.Errorf("http: invalid timeout %q: %s", config["timeout"], err)
+		}
+		timeout = d
+	}
+
+	var expectBody *regexp.Regexp
+	if config["expect_body"] != "" {
+		r, err := regexp.Compile(config["expect_body"])
+		if err != nil {
+			return res, fmt.Errorf("http: invalid expect_body %q: %s", config["expect_body"], err)
+		}
+		expectBody = r
+	}
+
+	expectCodes, err := parseCodes(config["expect_codes"])
+	if err != nil {
+		return res, err
+	}
+
+	client, err := newClient(config, timeout)
+	if err != nil {
+		return res, err
+	}
+	// Every execution gets its own transport, don't leave its connections open
+	defer client.CloseIdleConnections()
+
+	req, err := newRequest(ctx, config)
+	if err != nil {
+		return res, err
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return res, fmt.Errorf("http: request error: %s", err)
+	}
+	defer resp.Body.Close()
+
+	// Read one byte more to know if the body is longer, the rest isn't read
+	body, err := ioutil.ReadAll(io.LimitReader(resp.Body, maxBodySize+1))
+	if err != nil {
+		return res, fmt.Errorf("http: error reading response: %s", err)
+	}
+	capped := len(body) > maxBodySize
+	if capped {
+		body = body[:maxBodySize]
+	}
+
+	res.Output = formatOutput(resp, body, capped)
+	res.Results = map[string]string{
+		"status_code": strconv.Itoa(resp.StatusCode),
+	}
+
+	if !expectedCode(resp.StatusCode, expectCodes) {
+		return res, fmt.Errorf("http: unexpected status code %d", resp.StatusCode)
+	}
+	if expectBody != nil && !expectBody.Match(body) {
+		return res, fmt.Errorf("http: response body doesn't match %q", expectBody)
+	}
+
+	return res, nil
+}
+
+func newRequest(ctx context.Context, config map[string]string) (*http.Request, error) {
+	method := strings.ToUpper(config["method"])
+	if method == "" {
+		method = http.MethodGet
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, config["url"], bytes.NewBufferString(config["body"]))
+	if err != nil {
+		return nil, fmt.Errorf("http: invalid request: %s", err)
+	}
+
+	if config["headers"] != "" {
+		var headers []string
+		if err := json.Unmarshal([]byte(config["headers"]), &headers); err != nil {
+			return nil, fmt.Errorf("http: invalid headers, use a JSON list like [\"Name: value\"]: %s", err)
+		}
+		for _, h := range headers {
+			kv := strings.SplitN(h, ":", 2)
+			if len(kv) != 2 {
+				return nil, fmt.Errorf("http: invalid header %q, use \"Name: value\"", h)
+			}
+			req.Header.Set(strings.TrimSpace(kv[0]), strings.TrimSpace(kv[1]))
+		}
+	}
+
+	return req, nil
+}
+
+func newClient(config map[string]string, timeout time.Duration) (*http.Client, error) {
+	tlsConfig := &tls.Config{
+		InsecureSkipVerify: config["tls_insecure_skip_verify"] == "true",
+	}
+
+	if config["tls_cert_file"] != "" || config["tls_key_file"] != "" {
+		cert, err := tls.LoadX509KeyPair(config["tls_cert_file"], config["tls_key_file"])
+		if err != nil {
+			return nil, fmt.Errorf("http: error loading client certificate: %s", err)
+		}
+		tlsConfig.Certificates = []tls.Certificate{cert}
+	}
+
+	if config["tls_ca_file"] != "" {
+		ca, err := ioutil.ReadFile(config["tls_ca_file"])
+		if err != nil {
+			return nil, fmt.Errorf("http: error reading CA file: %s", err)
+		}
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(ca) {
+			return nil, fmt.Errorf("http: no valid certificates found in %s", config["tls_ca_file"])
+		}
+		tlsConfig.RootCAs = pool
+	}
+
+	return &http.Client{
+		Timeout: timeout,
+		Transport: &http.Transport{
+			Proxy:           http.ProxyFromEnvironment,
+			TLSClientConfig: tlsConfig,
+		},
+	}, nil
+}
+
+// parseCodes parses a comma separated list of status codes.
+func parseCodes(codes string) ([]int, error) {
+	var res []int
+	if codes == "" {
+		return res, nil
+	}
+	for _, c := range strings.Split(codes, ",") {
+		code, err := strconv.Atoi(strings.TrimSpace(c))
+		if err != nil {
+			return nil, fmt.Errorf("http: invalid expect_codes %q", codes)
+		}
+		res = append(res, code)
+	}
+	return res, nil
+}
+
+// expectedCode checks the status code against the expected ones,
+// any 2xx code is expected when none is given.
+func expectedCode(code int, expected []int) bool {
+	if len(expected) == 0 {
+		return code >= 200 && code < 300
+	}
+	for _, c := range expected {
+		if c == code {
+			return true
+		}
+	}
+	return false
+}
+
+// formatOutput returns the status line and the body, capped tells the body
+// read stopped at maxBodySize before its end.
+func formatOutput(resp *http.Response, body []byte, capped bool) []byte {
+	var out bytes.Buffer
+	fmt.Fprintf(&out, "%s %s\n", resp.Proto, resp.Status)
+	if !capped && len(body) <= maxOutputBody {
+		out.Write(body)
+		return out.Bytes()
+	}
+
+	received := fmt.Sprintf("%d bytes", len(body))
+	if capped {
+		received = fmt.Sprintf("more than %d bytes", maxBodySize)
+	}
+	if len(body) > maxOutputBody {
+		body = body[:maxOutputBody]
+	}
+	out.Write(body)
+	fmt.Fprintf(&out, "\nhttp: body truncated, %s received, first %d bytes kept", received, len(body))
+	return out.Bytes()
+}
@@ -0,0 +1,171 @@
+package httpexec
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"spiderjob/lib/plugin/types"
+)
+
+func TestExecute(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/ok", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"status": "ok"}`)
+	})
+	mux.HandleFunc("/created", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusCreated)
+	})
+	mux.HandleFunc("/error", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	})
+	mux.HandleFunc("/slow", func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-time.After(2 * time.Second):
+		case <-r.Context().Done():
+		}
+	})
+	mux.HandleFunc("/large", func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(strings.Repeat("a", maxOutputBody+100)))
+	})
+	mux.HandleFunc("/huge", func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(strings.Repeat("a", maxBodySize+100)))
+	})
+	mux.HandleFunc("/echo", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, "%s %s", r.Method, r.Header.Get("X-Test"))
+	})
+	srv := httptest.NewServer(mux)
+	defer srv.Close()
+
+	tests := []struct {
+		name       string
+		config     map[string]string
+		wantError  string
+		wantOutput string
+	}{
+		{
+			name:       "any 2xx by default",
+			config:     map[string]string{"url": srv.URL + "/ok"},
+			wantOutput: `{"status": "ok"}`,
+		},
+		{
+			name:      "5xx fails by default",
+			config:    map[string]string{"url": srv.URL + "/error"},
+			wantError: "unexpected status code 500",
+		},
+		{
+			name:   "expected code",
+			config: map[string]string{"url": srv.URL + "/error", "expect_codes": "200, 500"},
+		},
+		{
+			name:      "unexpected code",
+			config:    map[string]string{"url": srv.URL + "/created", "expect_codes": "200"},
+			wantError: "unexpected status code 201",
+		},
+		{
+			name:      "invalid expected codes",
+			config:    map[string]string{"url": srv.URL + "/ok", "expect_codes": "200,abc"},
+			wantError: "invalid expect_codes",
+		},
+		{
+			name:   "body matches",
+			config: map[string]string{"url": srv.URL + "/ok", "expect_body": `"status":\s*"ok"`},
+		},
+		{
+			name:      "body doesn't match",
+			config:    map[string]string{"url": srv.URL + "/ok", "expect_body": "error"},
+			wantError: "response body doesn't match",
+		},
+		{
+			name:      "timeout",
+			config:    map[string]string{"url": srv.URL + "/slow", "timeout": "100ms"},
+			wantError: "request error",
+		},
+		{
+			name:      "invalid timeout",
+			config:    map[string]string{"url": srv.URL + "/ok", "timeout": "soon"},
+			wantError: "invalid timeout",
+		},
+		{
+			name:       "body truncated",
+			config:     map[string]string{"url": srv.URL + "/large"},
+			wantOutput: fmt.Sprintf("body truncated, %d bytes received, first %d bytes kept", maxOutputBody+100, maxOutputBody),
+		},
+		{
+			name:       "body read capped",
+			config:     map[string]string{"url": srv.URL + "/huge"},
+			wantOutput: fmt.Sprintf("body truncated, more than %d bytes received, first %d bytes kept", maxBodySize, maxOutputBody),
+		},
+		{
+			name: "method and headers",
+			config: map[string]string{
+				"url":     srv.URL + "/echo",
+				"method":  "post",
+				"headers": `["X-Test: value"]`,
+			},
+			wantOutput: "POST value",
+		},
+		{
+			name:      "no url",
+			config:    map[string]string{},
+			wantError: ErrNoURL.Error(),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := &HTTP{}
+			res, err := h.Execute(context.Background(), &types.ExecuteRequest{
+				JobName: "test",
+				Config:  tt.config,
+			}, nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if tt.wantError == "" && res.Error != "" {
+				t.Fatalf("got error %q", res.Error)
+			}
+			if !strings.Contains(res.Error, tt.wantError) {
+				t.Fatalf("got error %q, want %q", res.Error, tt.wantError)
+			}
+			if !strings.Contains(string(res.Output), tt.wantOutput) {
+				t.Fatalf("output %q doesn't contain %q", tail(res.Output), tt.wantOutput)
+			}
+		})
+	}
+}
+
+func TestExecuteCancelled(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		<-r.Context().Done()
+	}))
+	defer srv.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	time.AfterFunc(100*time.Millisecond, cancel)
+
+	h := &HTTP{}
+	res, err := h.Execute(ctx, &types.ExecuteRequest{
+		JobName: "test",
+		Config:  map[string]string{"url": srv.URL},
+	}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(res.Error, "context canceled") {
+		t.Fatalf("got error %q, want the request cancelled", res.Error)
+	}
+}
+
+// tail returns the end of the output, to keep failures readable.
+func tail(b []byte) string {
+	if len(b) > 200 {
+		b = b[len(b)-200:]
+	}
+	return string(b)
+}
@@ -0,0 +1,163 @@
+package shell
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"strings"
+	"sync"
+
+	"spiderjob/lib/plugin"
+	"spiderjob/lib/plugin/types"
+
+	"github.com/armon/circbuf"
+)
+
+const (
+	// maxBufSize limits how much data we collect from a handler.
+	maxBufSize = 256000
+)
+
+var (
+	// ErrNoCommand is returned when the job doesn't define a command to run.
+	ErrNoCommand = errors.New("shell: unspecified command for job")
+)
+
+// Shell is the built-in executor running commands in a shell.
+//
+// Accepted executor_config keys:
+//
+//	command: command to run, required
+//	shell:   shell used to run the command, defaults to /bin/sh
+//	env:     JSON list of variables added to the environment, like ["KEY=value"]
+//	cwd:     working directory of the command
+//	user:    name or uid of the user to run the command as
+//	group:   name or gid of the group to run the command as
+type Shell struct{}
+
+// New returns a new shell executor.
+func New() plugin.Executor {
+	return &Shell{}
+}
+
+// Execute runs the command, streaming its stdout and stderr through cb.
+// The command and all its children are killed when ctx is done.
+func (s *Shell) Execute(ctx context.Context, args *types.ExecuteRequest, cb plugin.StatusHelper) (*types.ExecuteResponse, error) {
+	resp, err := s.ExecuteImpl(ctx, args, cb)
+	if err != nil {
+		resp.Error = err.Error()
+	}
+	return resp, nil
+}
+
+// ExecuteImpl runs the command and returns its combined output, the
+// output streams and the exit code.
+func (s *Shell) ExecuteImpl(ctx context.Context, args *types.ExecuteRequest, cb plugin.StatusHelper) (*types.ExecuteResponse, error) {
+	resp := &types.ExecuteResponse{}
+	output, _ := circbuf.NewBuffer(maxBufSize)
+	stdout, _ := circbuf.NewBuffer(maxBufSize)
+	stderr, _ := circbuf.NewBuffer(maxBufSize)
+
+	command := args.Config["command"]
+	if command == "" {
+		return resp, ErrNoCommand
+	}
+
+	cmd, err := buildCmd(args.Config)
+	if err != nil {
+		return resp, err
+	}
+	// stdout and stderr are copied concurrently to the same buffer
+	mu := &sync.Mutex{}
+	cmd.Stdout = &reportingWriter{buffer: output, stream: stdout, cb: cb, isError: false, mu: mu}
+	cmd.Stderr = &reportingWriter{buffer: output, stream: stderr, cb: cb, isError: true, mu: mu}
+
+	if err := cmd.Start(); err != nil {
+		return resp, err
+	}
+
+	// Kill the whole process tree if the execution is stopped
+	done := make(chan struct{})
+	defer close(done)
+	go func() {
+		select {
+		case <-ctx.Done():
+			killProcess(cmd)
+		case <-done:
+		}
+	}()
+
+	err = cmd.Wait()
+	if output.TotalWritten() > output.Size() {
+		output.Write([]byte(fmt.Sprintf("\nshell: output truncated, %d bytes written, last %d bytes kept", output.TotalWritten(), output.Size())))
+	}
+	resp.Output = output.Bytes()
+	resp.Stdout = stdout.Bytes()
+	resp.Stderr = stderr.Bytes()
+	if cmd.ProcessState != nil {
+		resp.ExitCode = int32(cmd.ProcessState.ExitCode())
+	}
+
+	if ctx.Err() != nil {
+		return resp, ctx.Err()
+	}
+	if exitErr, ok := err.(*exec.ExitError); ok {
+		return resp, fmt.Errorf("shell: command exited with code %d", exitErr.ExitCode())
+	}
+	return resp, err
+}
+
+// buildCmd creates the command with the environment, working directory
+// and credentials given in the executor config.
+func buildCmd(config map[string]string) (*exec.Cmd, error) {
+	shell := defaultShell
+	if config["shell"] != "" {
+		shell = []string{config["shell"], shell[len(shell)-1]}
+	}
+	cmd := exec.Command(shell[0], append(shell[1:], config["command"])...)
+
+	cmd.Env = os.Environ()
+	if config["env"] != "" {
+		var env []string
+		if err := json.Unmarshal([]byte(config["env"]), &env); err != nil {
+			return nil, fmt.Errorf("shell: invalid env, use a JSON list like [\"KEY=value\"]: %s", err)
+		}
+		for _, kv := range env {
+			if !strings.Contains(kv, "=") {
+				return nil, fmt.Errorf("shell: invalid env value %q, use KEY=value", kv)
+			}
+			cmd.Env = append(cmd.Env, kv)
+		}
+	}
+	cmd.Dir = config["cwd"]
+
+	if err := setProcAttributes(cmd, config["user"], config["group"]); err != nil {
+		return nil, err
+	}
+
+	return cmd, nil
+}
+
+// reportingWriter sends every chunk written by the command to the
+// agent and keeps it in the combined output and the stream buffers.
+type reportingWriter struct {
+	buffer  *circbuf.Buffer
+	stream  *circbuf.Buffer
+	cb      plugin.StatusHelper
+	isError bool
+	mu      *sync.Mutex
+}
+
+func (w *reportingWriter) Write(data []byte) (int, error) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if w.cb != nil {
+		w.cb.Update(data, w.isError)
+	}
+	w.stream.Write(data)
+	return w.buffer.Write(data)
+}
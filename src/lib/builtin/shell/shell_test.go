@@ -0,0 +1,97 @@
+package shell
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"runtime"
+	"strings"
+	"testing"
+
+	"spiderjob/lib/plugin/types"
+)
+
+func TestExecute(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("commands use a POSIX shell")
+	}
+	dir, err := ioutil.TempDir("", "spiderjob-shell")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	tests := []struct {
+		name         string
+		config       map[string]string
+		wantError    string
+		wantOutput   string
+		wantExitCode int32
+	}{
+		{
+			name:       "output",
+			config:     map[string]string{"command": "echo out; echo err >&2"},
+			wantOutput: "out\nerr\n",
+		},
+		{
+			name:         "exit code",
+			config:       map[string]string{"command": "exit 3"},
+			wantError:    "shell: command exited with code 3",
+			wantExitCode: 3,
+		},
+		{
+			name: "env",
+			config: map[string]string{
+				"command": `echo "$A $B"`,
+				"env":     `["A=1,2", "B=x=y"]`,
+			},
+			wantOutput: "1,2 x=y\n",
+		},
+		{
+			name:      "invalid env",
+			config:    map[string]string{"command": "true", "env": "A=1,B=2"},
+			wantError: "shell: invalid env",
+		},
+		{
+			name:      "env without value",
+			config:    map[string]string{"command": "true", "env": `["A"]`},
+			wantError: `shell: invalid env value "A"`,
+		},
+		{
+			name:       "cwd",
+			config:     map[string]string{"command": "pwd", "cwd": dir},
+			wantOutput: dir + "\n",
+		},
+		{
+			name:      "no command",
+			config:    map[string]string{},
+			wantError: ErrNoCommand.Error(),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := &Shell{}
+			res, err := s.Execute(context.Background(), &types.ExecuteRequest{
+				JobName: "test",
+				Config:  tt.config,
+			}, nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if tt.wantError == "" && res.Error != "" {
+				t.Fatalf("got error %q", res.Error)
+			}
+			if !strings.Contains(res.Error, tt.wantError) {
+				t.Fatalf("got error %q, want %q", res.Error, tt.wantError)
+			}
+			if tt.wantOutput != "" && string(res.Output) != tt.wantOutput {
+				t.Fatalf("got output %q, want %q", res.Output, tt.wantOutput)
+			}
+			if res.ExitCode != tt.wantExitCode {
+				t.Fatalf("got exit code %d, want %d", res.ExitCode, tt.wantExitCode)
+			}
+		})
+	}
+}
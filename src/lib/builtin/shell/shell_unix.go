@@ -0,0 +1,85 @@
+//go:build !windows
+// +build !windows
+
+package shell
+
+import (
+	"fmt"
+	"os/exec"
+	"os/user"
+	"strconv"
+	"syscall"
+)
+
+var defaultShell = []string{"/bin/sh", "-c"}
+
+// setProcAttributes runs the command in its own process group,
+// as the given user and group if any.
+func setProcAttributes(cmd *exec.Cmd, username, group string) error {
+	cmd.SysProcAttr = &syscall.SysProcAttr{Setpgid: true}
+
+	if username == "" && group == "" {
+		return nil
+	}
+
+	cred := &syscall.Credential{
+		Uid: uint32(syscall.Getuid()),
+		Gid: uint32(syscall.Getgid()),
+	}
+	if username != "" {
+		u, err := lookupUser(username)
+		if err != nil {
+			return err
+		}
+		uid, _ := strconv.ParseUint(u.Uid, 10, 32)
+		gid, _ := strconv.ParseUint(u.Gid, 10, 32)
+		cred.Uid = uint32(uid)
+		cred.Gid = uint32(gid)
+	}
+	if group != "" {
+		g, err := lookupGroup(group)
+		if err != nil {
+			return err
+		}
+		gid, _ := strconv.ParseUint(g.Gid, 10, 32)
+		cred.Gid = uint32(gid)
+	}
+	cmd.SysProcAttr.Credential = cred
+
+	return nil
+}
+
+// killProcess kills the process group of the command,
+// so processes started by the shell are stopped too.
+func killProcess(cmd *exec.Cmd) {
+	if cmd.Process == nil {
+		return
+	}
+	syscall.Kill(-cmd.Process.Pid, syscall.SIGKILL)
+}
+
+func lookupUser(name string) (*user.User, error) {
+	if _, err := strconv.Atoi(name); err == nil {
+		if u, err := user.LookupId(name); err == nil {
+			return u, nil
+		}
+	}
+	u, err := user.Lookup(name)
+	if err != nil {
+		return nil, fmt.Errorf("shell: unknown user %s: %s", name, err)
+	}
+	return u, nil
+}
+
+func lookupGroup(name string) (*user.Group, error) {
+	if _, err := strconv.Atoi(name); err == nil {
+		if g, err := user.LookupGroupId(name); err == nil {
+			return g, nil
+		}
+	}
+	g, err := user.LookupGroup(name)
+	if err != nil {
+		return nil, fmt.Errorf("shell: unknown group %s: %s", name, err)
+	}
+	return g, nil
+}
@@ -0,0 +1,175 @@
+//go:build !windows
+// +build !windows
+
+package shell
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"os/user"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"syscall"
+	"testing"
+	"time"
+
+	"spiderjob/lib/plugin/types"
+)
+
+// TestExecuteKillsProcessGroup checks the processes started by the
+// command are killed with it when the execution is stopped.
+func TestExecuteKillsProcessGroup(t *testing.T) {
+	dir, err := ioutil.TempDir("", "spiderjob-shell")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	pidFile := filepath.Join(dir, "pid")
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go func() {
+		// Stop the execution once the background process started
+		for ctx.Err() == nil {
+			if b, _ := ioutil.ReadFile(pidFile); strings.HasSuffix(string(b), "\n") {
+				cancel()
+				return
+			}
+			time.Sleep(10 * time.Millisecond)
+		}
+	}()
+
+	s := &Shell{}
+	start := time.Now()
+	res, err := s.Execute(ctx, &types.ExecuteRequest{
+		JobName: "test",
+		Config:  map[string]string{"command": fmt.Sprintf("sleep 30 & echo $! > %s; wait", pidFile)},
+	}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.Error != context.Canceled.Error() {
+		t.Fatalf("got error %q, want %q", res.Error, context.Canceled)
+	}
+	if time.Since(start) > 10*time.Second {
+		t.Fatal("execution didn't stop when cancelled")
+	}
+
+	b, err := ioutil.ReadFile(pidFile)
+	if err != nil {
+		t.Fatal(err)
+	}
+	pid, err := strconv.Atoi(strings.TrimSpace(string(b)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i := 0; processRunning(pid); i++ {
+		if i == 100 {
+			t.Fatalf("background process %d still running", pid)
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
+
+// processRunning returns if the process exists and isn't a zombie
+// waiting to be reaped.
+func processRunning(pid int) bool {
+	if err := syscall.Kill(pid, 0); err != nil {
+		return false
+	}
+	stat, err := ioutil.ReadFile(fmt.Sprintf("/proc/%d/stat", pid))
+	if err != nil {
+		// No procfs, orphans are reaped right away
+		return true
+	}
+	// The state follows the command name between parentheses
+	fields := strings.Fields(string(stat[strings.LastIndex(string(stat), ")")+1:]))
+	return len(fields) == 0 || fields[0] != "Z"
+}
+
+func TestSetProcAttributes(t *testing.T) {
+	current, err := user.Current()
+	if err != nil {
+		t.Skip(err)
+	}
+	group, err := user.LookupGroupId(current.Gid)
+	if err != nil {
+		t.Skip(err)
+	}
+	uid, _ := strconv.ParseUint(current.Uid, 10, 32)
+	gid, _ := strconv.ParseUint(current.Gid, 10, 32)
+
+	tests := []struct {
+		name      string
+		user      string
+		group     string
+		wantCred  bool
+		wantError string
+	}{
+		{
+			name: "current credentials",
+		},
+		{
+			name:     "user name",
+			user:     current.Username,
+			wantCred: true,
+		},
+		{
+			name:     "uid",
+			user:     current.Uid,
+			wantCred: true,
+		},
+		{
+			name:     "group name",
+			group:    group.Name,
+			wantCred: true,
+		},
+		{
+			name:     "gid",
+			group:    current.Gid,
+			wantCred: true,
+		},
+		{
+			name:      "unknown user",
+			user:      "spiderjob-missing-user",
+			wantError: "shell: unknown user spiderjob-missing-user",
+		},
+		{
+			name:      "unknown group",
+			group:     "spiderjob-missing-group",
+			wantError: "shell: unknown group spiderjob-missing-group",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cmd, err := buildCmd(map[string]string{"command": "true", "user": tt.user, "group": tt.group})
+			if tt.wantError != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantError) {
+					t.Fatalf("got error %v, want %q", err, tt.wantError)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if !cmd.SysProcAttr.Setpgid {
+				t.Fatal("command not run in its own process group")
+			}
+			cred := cmd.SysProcAttr.Credential
+			if !tt.wantCred {
+				if cred != nil {
+					t.Fatalf("got credential %+v, want none", cred)
+				}
+				return
+			}
+			if cred == nil || cred.Uid != uint32(uid) || cred.Gid != uint32(gid) {
+				t.Fatalf("got credential %+v, want uid %d and gid %d", cred, uid, gid)
+			}
+		})
+	}
+}
@@ -0,0 +1,28 @@
+//go:build windows
+// +build windows
+
+package shell
+
+import (
+	"errors"
+	"os/exec"
+)
+
+var defaultShell = []string{"cmd", "/C"}
+
+// setProcAttributes fails if a user or group is requested,
+// switching credentials is not supported on windows.
+func setProcAttributes(cmd *exec.Cmd, username, group string) error {
+	if username != "" || group != "" {
+		return errors.New("shell: running as another user or group is not supported on windows")
+	}
+	return nil
+}
+
+// killProcess kills the command process.
+func killProcess(cmd *exec.Cmd) {
+	if cmd.Process == nil {
+		return
+	}
+	cmd.Process.Kill()
+}
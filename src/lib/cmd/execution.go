@@ -0,0 +1,66 @@
+package cmd
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"os"
+
+	"github.com/spf13/cobra"
+)
+
+var (
+	executionAPIAddr string
+	jobName          string
+	stoppedBy        string
+)
+
+// executionCmd groups the commands that operate on executions
+var executionCmd = &cobra.Command{
+	Use:   "execution [command]",
+	Short: "Command to perform operations on executions",
+	Long:  ``,
+}
+
+var executionStopCmd = &cobra.Command{
+	Use:   "stop [execution id]",
+	Short: "Command to stop a running execution",
+	Long:  `Stop a running execution, the executor is cancelled and the execution is recorded as cancelled by the given user`,
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		q := url.Values{}
+		q.Set("user", stoppedBy)
+		u := fmt.Sprintf("%s/v1/jobs/%s/executions/%s?%s",
+			executionAPIAddr, url.PathEscape(jobName), url.PathEscape(args[0]), q.Encode())
+
+		req, err := http.NewRequest(http.MethodDelete, u, nil)
+		if err != nil {
+			return err
+		}
+		resp, err := http.DefaultClient.Do(req)
+		if err != nil {
+			return err
+		}
+		defer resp.Body.Close()
+
+		if resp.StatusCode != http.StatusAccepted {
+			body, _ := ioutil.ReadAll(resp.Body)
+			return fmt.Errorf("error stopping execution: %s %s", resp.Status, body)
+		}
+		fmt.Println("Execution stopped")
+
+		return nil
+	},
+}
+
+func init() {
+	executionCmd.PersistentFlags().StringVar(&executionAPIAddr, "api-addr", "http://127.0.0.1:8080", "HTTP API address of a server.")
+	executionStopCmd.Flags().StringVar(&jobName, "job", "", "Name of the job the execution belongs to.")
+	executionStopCmd.Flags().StringVar(&stoppedBy, "user", os.Getenv("USER"), "Name of the user stopping the execution.")
+	executionStopCmd.MarkFlagRequired("job")
+
+	executionCmd.AddCommand(executionStopCmd)
+
+	spiderjobCmd.AddCommand(executionCmd)
+}
@@ -0,0 +1,56 @@
+package cmd
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+
+	"github.com/spf13/cobra"
+)
+
+var (
+	graphAPIAddr string
+	graphRoot    string
+	graphFormat  string
+)
+
+// graphCmd prints the dependency graph of the jobs
+var graphCmd = &cobra.Command{
+	Use:   "graph",
+	Short: "Command to print the dependency graph of the jobs",
+	Long:  `Print the dependency graph of the jobs with the last status of each job, as DOT, Mermaid or JSON`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		q := url.Values{}
+		q.Set("format", graphFormat)
+		if graphRoot != "" {
+			q.Set("root", graphRoot)
+		}
+		u := fmt.Sprintf("%s/v1/graph?%s", graphAPIAddr, q.Encode())
+
+		resp, err := http.Get(u)
+		if err != nil {
+			return err
+		}
+		defer resp.Body.Close()
+
+		body, err := ioutil.ReadAll(resp.Body)
+		if err != nil {
+			return err
+		}
+		if resp.StatusCode != http.StatusOK {
+			return fmt.Errorf("error getting graph: %s %s", resp.Status, body)
+		}
+		fmt.Print(string(body))
+
+		return nil
+	},
+}
+
+func init() {
+	graphCmd.Flags().StringVar(&graphAPIAddr, "api-addr", "http://127.0.0.1:8080", "HTTP API address of a server.")
+	graphCmd.Flags().StringVar(&graphRoot, "root", "", "Name of the job the graph is rooted at, the whole cluster if not set.")
+	graphCmd.Flags().StringVar(&graphFormat, "format", "dot", "Output format: dot, mermaid or json.")
+
+	spiderjobCmd.AddCommand(graphCmd)
+}
@@ -8,7 +8,12 @@ import (
 	"github.com/spf13/cobra"
 )
 
-// versionCmd represents the version command
+var (
+	rpcAddr string
+	ip      string
+)
+
+// raftCmd represents the raft command
 var raftCmd = &cobra.Command{
 	Use:   "raft [command]",
 	Short: "Command to perform some raft operations",
@@ -78,5 +83,5 @@ func init() {
 	raftCmd.AddCommand(raftListCmd)
 	raftCmd.AddCommand(raftRemovePeerCmd)
 
-	dkronCmd.AddCommand(raftCmd)
+	spiderjobCmd.AddCommand(raftCmd)
 }
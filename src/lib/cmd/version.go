@@ -8,7 +8,7 @@ import (
 	"github.com/spf13/cobra"
 )
 
-var versionCmd = &cobra.Comman{
+var versionCmd = &cobra.Command{
 	Use:   "version",
 	Short: "Show version",
 	Long:  `Show the version`,
@@ -0,0 +1,95 @@
+package core
+
+import (
+	"time"
+
+	"spiderjob/lib/extcron"
+
+	"github.com/robfig/cron/v3"
+)
+
+// afterCompletionDelay is the minimum delay before an overdue run, so
+// the run isn't due before the cron picks up the entry.
+const afterCompletionDelay = time.Second
+
+// afterCompletion returns the after-completion schedule wrapped in the schedule, if any.
+func afterCompletion(schedule cron.Schedule) (extcron.AfterCompletionSchedule, bool) {
+	if ex, ok := schedule.(extcron.ExcludeSchedule); ok {
+		schedule = ex.Schedule
+	}
+	ac, ok := schedule.(extcron.AfterCompletionSchedule)
+	return ac, ok
+}
+
+// lastFinished returns the finish time of the last execution of the job,
+// zero if it never ran.
+func (j *Job) lastFinished() time.Time {
+	var last time.Time
+	if j.LastSuccess.HasValue() {
+		last = j.LastSuccess.Get()
+	}
+	if j.LastError.HasValue() && j.LastError.Get().After(last) {
+		last = j.LastError.Get()
+	}
+	return last
+}
+
+// afterCompletionSchedule returns the schedule of the next run of an
+// after-completion job, anchored at the end of its last run or at the
+// missed run, and moved to the next day not excluded by its calendars.
+func (j *Job) afterCompletionSchedule(schedule cron.Schedule, anchor, now time.Time) cron.Schedule {
+	ac, _ := afterCompletion(schedule)
+	next := ac.NextAfter(anchor, now.Add(afterCompletionDelay))
+
+	if ex, ok := schedule.(extcron.ExcludeSchedule); ok {
+		next = ex.NextIncluded(next.In(j.location()))
+	}
+	return extcron.At(next)
+}
+
+// rearmAfterCompletion arms the next run of an after-completion job
+// once every execution of the group finished. This only works on the leader.
+func (a *Agent) rearmAfterCompletion(job *Job, group []*Execution) error {
+	schedule, err := job.cronSchedule(a.Store)
+	if err != nil {
+		return err
+	}
+	if _, ok := afterCompletion(schedule); !ok {
+		return nil
+	}
+
+	for _, e := range group {
+		if e.FinishedAt.IsZero() {
+			return nil
+		}
+	}
+
+	log.WithField("job", job.Name).Debug("agent: Rearming job after completion")
+	job.Agent = a
+	return a.sched.AddJob(job)
+}
+
+// rearmMissedRun arms the next run of an after-completion job whose scheduled
+// run didn't happen, one interval after now as there is no run to wait for.
+// This only works on the leader.
+func (a *Agent) rearmMissedRun(name string) {
+	job, err := a.Store.GetJob(name, nil)
+	if err != nil {
+		log.WithError(err).WithField("job", name).Error("agent: Error rearming job after a missed run")
+		return
+	}
+	schedule, err := job.cronSchedule(a.Store)
+	if err != nil {
+		log.WithError(err).WithField("job", name).Error("agent: Error rearming job after a missed run")
+		return
+	}
+	if _, ok := afterCompletion(schedule); !ok {
+		return
+	}
+
+	log.WithField("job", name).Debug("agent: Rearming job after a missed run")
+	job.Agent = a
+	if err := a.sched.addJob(job, time.Now()); err != nil {
+		log.WithError(err).WithField("job", name).Error("agent: Error rearming job after a missed run")
+	}
+}
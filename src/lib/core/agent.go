@@ -1,6 +1,7 @@
 package core
 
 import (
+	"context"
 	"crypto/tls"
 	"errors"
 	"expvar"
@@ -15,6 +16,9 @@ import (
 	"sync"
 	"time"
 
+	"spiderjob/lib/builtin/grpcexec"
+	"spiderjob/lib/builtin/httpexec"
+	"spiderjob/lib/builtin/shell"
 	"spiderjob/lib/plugin"
 	proto "spiderjob/lib/plugin/types"
 
@@ -26,7 +30,9 @@ import (
 	"github.com/sirupsen/logrus"
 	"github.com/soheilhy/cmux"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/status"
 )
 
 const (
@@ -46,6 +52,9 @@ var (
 	// ErrNoSuitableServer returns an error in case no suitable server to send the request is found.
 	ErrNoSuitableServer = errors.New("no suitable server found to send the request, aborting")
 
+	// ErrExecutionNotFound is returned when the requested execution is not running in the cluster.
+	ErrExecutionNotFound = errors.New("no running execution found with the given id")
+
 	runningExecutions sync.Map
 )
 
@@ -109,6 +118,26 @@ type Agent struct {
 
 	activeExecutions sync.Map
 
+	// executionStoppers holds the executionStopper of every execution
+	// running in this node, indexed by execution key.
+	executionStoppers sync.Map
+
+	// executionBroker forwards the updates of the executions
+	// streamed to this server to the clients following them.
+	executionBroker *executionBroker
+
+	// retryTimers holds the timers of the retries scheduled
+	// by this server while it's the leader, indexed by execution key.
+	retryTimers map[string]*time.Timer
+	retryLock   sync.Mutex
+
+	// logStore keeps the full output of the executions run in this node.
+	logStore *LogStore
+
+	// storeRecovered is set when the store was loaded from disk with
+	// previous state, so Raft doesn't need to restore snapshots on start.
+	storeRecovered bool
+
 	listener net.Listener
 }
 
@@ -129,17 +158,37 @@ type AgentOption func(agent *Agent)
 // and running a Dkron instance.
 func NewAgent(config *Config, options ...AgentOption) *Agent {
 	agent := &Agent{
-		config:      config,
-		retryJoinCh: make(chan error),
+		config:          config,
+		retryJoinCh:     make(chan error),
+		executionBroker: newExecutionBroker(),
 	}
 
 	for _, option := range options {
 		option(agent)
 	}
 
+	// Register built-in executors, external plugins with the same name take precedence
+	if agent.ExecutorPlugins == nil {
+		agent.ExecutorPlugins = make(map[string]plugin.Executor)
+	}
+	for name, executor := range builtinExecutors() {
+		if _, ok := agent.ExecutorPlugins[name]; !ok {
+			agent.ExecutorPlugins[name] = executor
+		}
+	}
+
 	return agent
 }
 
+// builtinExecutors returns the executors shipped with the agent.
+func builtinExecutors() map[string]plugin.Executor {
+	return map[string]plugin.Executor{
+		"shell": shell.New(),
+		"http":  httpexec.New(),
+		"grpc":  grpcexec.New(),
+	}
+}
+
 // Start the current agent by running all the necessary
 // checks and server or client routines.
 func (a *Agent) Start() error {
@@ -168,6 +217,12 @@ func (a *Agent) Start() error {
 	// Expose the node name
 	expNode.Set(a.config.NodeName)
 
+	ls, err := a.newLogStore()
+	if err != nil {
+		return fmt.Errorf("agent: Can not setup the execution log store, %s", err)
+	}
+	a.logStore = ls
+
 	//Use the value of "RPCPort" if AdvertiseRPCPort has not been set
 	if a.config.AdvertiseRPCPort <= 0 {
 		a.config.AdvertiseRPCPort = a.config.RPCPort
@@ -243,6 +298,12 @@ func (a *Agent) Stop() error {
 		a.sched.ClearCron()
 	}
 
+	if a.logStore != nil {
+		if err := a.logStore.Close(); err != nil {
+			log.WithError(err).Error("agent: Error closing the execution log store")
+		}
+	}
+
 	if err := a.serf.Leave(); err != nil {
 		return err
 	}
@@ -283,6 +344,11 @@ func (a *Agent) setupRaft() error {
 	config.LogOutput = logger
 	config.LocalID = raft.ServerID(a.config.NodeName)
 
+	// A persisted store already holds the state contained in the snapshots,
+	// only restore them when the store is starting empty. The FSM skips the
+	// replayed entries the store already holds by their index.
+	config.NoSnapshotRestoreOnStart = a.storeRecovered
+
 	// Build an all in-memory setup for dev mode, otherwise prepare a full
 	// disk-based setup.
 	var logStore raft.LogStore
@@ -489,7 +555,7 @@ func (a *Agent) SetConfig(c *Config) {
 // StartServer launch a new dkron server process
 func (a *Agent) StartServer() {
 	if a.Store == nil {
-		s, err := NewStore()
+		s, err := a.newStore()
 		if err != nil {
 			log.WithError(err).Fatal("dkron: Error initializing store")
 		}
@@ -566,6 +632,35 @@ func (a *Agent) StartServer() {
 	go a.monitorLeadership()
 }
 
+// newStore creates the store selected by the storage-backend option.
+// The disk backend is rejected in dev mode as it doesn't persist Raft either.
+func (a *Agent) newStore() (Storage, error) {
+	switch a.config.StorageBackend {
+	case StorageBackendMemory, "":
+		return NewStore()
+	case StorageBackendDisk:
+		if a.config.DevMode {
+			return nil, fmt.Errorf("storage backend %s is not supported in dev mode, use %s", StorageBackendDisk, StorageBackendMemory)
+		}
+		dir := filepath.Join(a.config.DataDir, "store")
+		if _, err := os.Stat(filepath.Join(dir, storeFileName)); err == nil {
+			a.storeRecovered = true
+		}
+		return NewFileStore(dir)
+	default:
+		return nil, fmt.Errorf("unknown storage backend: %s", a.config.StorageBackend)
+	}
+}
+
+// newLogStore creates the store for the full output of the executions
+// run in this node, dev mode uses a temporary directory.
+func (a *Agent) newLogStore() (*LogStore, error) {
+	if a.config.DevMode {
+		return NewTempLogStore()
+	}
+	return NewLogStore(filepath.Join(a.config.DataDir, "logs"))
+}
+
 // Utility method to get leader nodename
 func (a *Agent) leaderMember() (*serf.Member, error) {
 	l := a.raft.Leader()
@@ -683,7 +778,8 @@ func (a *Agent) join(addrs []string, replay bool) (n int, err error) {
 	return
 }
 
-func (a *Agent) processFilteredNodes(job *Job) (map[string]string, map[string]string, error) {
+// processFilteredNodes selects the nodes that run the job, the excluded nodes are never selected.
+func (a *Agent) processFilteredNodes(job *Job, exclude map[string]bool) (map[string]string, map[string]string, error) {
 	// The final set of nodes will be the intersection of all groups
 	tags := make(map[string]string)
 
@@ -699,7 +795,7 @@ func (a *Agent) processFilteredNodes(job *Job) (map[string]string, map[string]st
 	// Make a set of all members
 	execNodes := make(map[string]serf.Member)
 	for _, member := range a.serf.Members() {
-		if member.Status == serf.StatusAlive {
+		if member.Status == serf.StatusAlive && !exclude[member.Name] {
 			execNodes[member.Name] = member
 		}
 	}
@@ -816,6 +912,12 @@ func (a *Agent) applySetJob(job *proto.Job) error {
 		return ErrParentJobNotFound
 	case ErrSameParent:
 		return ErrParentJobNotFound
+	case ErrCalendarNotFound:
+		return ErrCalendarNotFound
+	case ErrDependencyCycle:
+		return ErrDependencyCycle
+	case ErrBothParents:
+		return ErrBothParents
 	}
 
 	return nil
@@ -852,6 +954,105 @@ func (a *Agent) GetActiveExecutions() ([]*proto.Execution, error) {
 	return executions, nil
 }
 
+// StopExecution finds the node running the given execution and asks it to stop it
+func (a *Agent) StopExecution(jobName, executionID, stoppedBy string) error {
+	exs, err := a.GetActiveExecutions()
+	if err != nil {
+		return err
+	}
+
+	for _, e := range exs {
+		if e.JobName != jobName || e.Key() != executionID {
+			continue
+		}
+		for _, m := range a.serf.Members() {
+			if m.Name == e.NodeName && m.Status == serf.StatusAlive {
+				return a.GRPCClient.StopExecution(m.Tags["rpc_addr"], jobName, executionID, stoppedBy)
+			}
+		}
+		return fmt.Errorf("agent: node %s running execution %s is gone", e.NodeName, executionID)
+	}
+
+	return ErrExecutionNotFound
+}
+
+// StreamExecution finds the server receiving the updates of a running execution
+// and calls fn for every update until the execution finishes or ctx is done.
+func (a *Agent) StreamExecution(ctx context.Context, jobName, executionID string, fn func(*proto.Execution) error) error {
+	for _, s := range a.LocalServers() {
+		exs, err := a.GRPCClient.GetActiveExecutions(s.RPCAddr.String())
+		if err != nil {
+			return err
+		}
+		for _, e := range exs {
+			if e.JobName == jobName && e.Key() == executionID {
+				return a.GRPCClient.StreamExecution(ctx, s.RPCAddr.String(), jobName, executionID, fn)
+			}
+		}
+	}
+
+	return ErrExecutionNotFound
+}
+
+// GetExecutionLog reads a range of the full output of an execution
+// from the node that run it.
+func (a *Agent) GetExecutionLog(jobName, executionID string, offset, limit int64) (*ExecutionLog, error) {
+	job, err := a.Store.GetJob(jobName, nil)
+	if err != nil {
+		return nil, err
+	}
+	exs, err := a.Store.GetExecutions(job.Name, &ExecutionOptions{
+		Timezone: job.GetTimeLocation(),
+	})
+	if err != nil {
+		return nil, ErrExecutionLogNotFound
+	}
+
+	for _, e := range exs {
+		if e.Id != executionID {
+			continue
+		}
+		for _, m := range a.serf.Members() {
+			if m.Name == e.NodeName && m.Status == serf.StatusAlive {
+				l, err := a.GRPCClient.GetExecutionLog(m.Tags["rpc_addr"], jobName, executionID, offset, limit)
+				if status.Code(err) == codes.NotFound {
+					return nil, ErrExecutionLogNotFound
+				}
+				return l, err
+			}
+		}
+		return nil, fmt.Errorf("agent: node %s that run execution %s is not available", e.NodeName, executionID)
+	}
+
+	return nil, ErrExecutionLogNotFound
+}
+
+// deleteExecutionLogs removes the logs of the executions from the nodes
+// that run them, every log of the job from every node when executions is nil.
+// Nodes that are not alive keep their logs.
+func (a *Agent) deleteExecutionLogs(jobName string, executions []*Execution) {
+	ids := make(map[string][]string)
+	for _, e := range executions {
+		ids[e.NodeName] = append(ids[e.NodeName], e.Key())
+	}
+
+	for _, m := range a.serf.Members() {
+		if m.Status != serf.StatusAlive {
+			continue
+		}
+		nodeIDs, ok := ids[m.Name]
+		if executions != nil && !ok {
+			continue
+		}
+		if err := a.GRPCClient.DeleteExecutionLogs(m.Tags["rpc_addr"], jobName, nodeIDs); err != nil {
+			log.WithError(err).WithFields(logrus.Fields{
+				"job":  jobName,
+				"node": m.Name,
+			}).Warn("agent: Error deleting execution logs")
+		}
+	}
+}
+
 func (a *Agent) recursiveSetJob(jobs []*Job) []string {
 	result := make([]string, 0)
 	for _, job := range jobs {
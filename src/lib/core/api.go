@@ -9,6 +9,8 @@ import (
 	"strconv"
 	"time"
 
+	proto "spiderjob/lib/plugin/types"
+
 	"github.com/gin-contrib/cors"
 	"github.com/gin-contrib/expvar"
 	"github.com/gin-gonic/gin"
@@ -102,6 +104,15 @@ func (h *HTTPTransport) APIRoutes(r *gin.RouterGroup, middleware ...gin.HandlerF
 
 	v1.GET("/busy", h.busyHandler)
 
+	v1.POST("/schedule/preview", h.schedulePreviewHandler)
+
+	v1.GET("/calendars", h.calendarsHandler)
+	v1.POST("/calendars", h.calendarCreateOrUpdateHandler)
+	calendars := v1.Group("/calendars")
+	calendars.GET("/:calendar", h.calendarGetHandler)
+	calendars.PUT("/:calendar", h.calendarCreateOrUpdateHandler)
+	calendars.DELETE("/:calendar", h.calendarDeleteHandler)
+
 	v1.POST("/jobs", h.jobCreateOrUpdateHandler)
 	v1.PATCH("/jobs", h.jobCreateOrUpdateHandler)
 	// Place fallback routes last
@@ -116,7 +127,16 @@ func (h *HTTPTransport) APIRoutes(r *gin.RouterGroup, middleware ...gin.HandlerF
 
 	// Place fallback routes last
 	jobs.GET("/:job", h.jobGetHandler)
+	jobs.GET("/:job/next", h.jobNextHandler)
 	jobs.GET("/:job/executions", h.executionsHandler)
+	jobs.DELETE("/:job/executions/:id", h.executionStopHandler)
+	jobs.GET("/:job/executions/:id/log", h.executionLogHandler)
+	jobs.GET("/:job/executions/:id/stream", h.executionStreamHandler)
+
+	v1.GET("/graph", h.graphHandler)
+
+	workflows := v1.Group("/workflows")
+	workflows.GET("/:root/runs/:id", h.workflowRunHandler)
 }
 
 // MetaMiddleware adds middleware to the gin Context.
@@ -221,6 +241,161 @@ func (h *HTTPTransport) jobGetHandler(c *gin.Context) {
 	renderJSON(c, http.StatusOK, job)
 }
 
+// jobNextHandler returns the next fire times of a job, the number
+// of them is set by the "count" query parameter.
+func (h *HTTPTransport) jobNextHandler(c *gin.Context) {
+	jobName := c.Param("job")
+
+	count, err := strconv.Atoi(c.DefaultQuery("count", strconv.Itoa(defaultPreviewCount)))
+	if err != nil {
+		c.AbortWithError(http.StatusBadRequest, err)
+		return
+	}
+
+	job, err := h.agent.Store.GetJob(jobName, nil)
+	if err != nil {
+		log.Error(err)
+	}
+	if job == nil {
+		c.AbortWithStatus(http.StatusNotFound)
+		return
+	}
+
+	// Dependent jobs only run after their parents
+	if job.isDependent() {
+		renderJSON(c, http.StatusOK, &SchedulePreview{Timezone: job.Timezone, Next: []*FireTime{}})
+		return
+	}
+
+	calendars, err := getCalendars(h.agent.Store, job.Calendars)
+	if err != nil {
+		c.AbortWithError(http.StatusInternalServerError, err)
+		return
+	}
+
+	preview, err := PreviewSchedule(job.Schedule, job.Timezone, job.Name, calendars, time.Now(), count)
+	if err != nil {
+		c.AbortWithError(http.StatusBadRequest, err)
+		return
+	}
+	renderJSON(c, http.StatusOK, preview)
+}
+
+// schedulePreviewHandler returns the next fire times of a schedule expression
+// without creating a job, H tokens are derived from the optional job name.
+// The days excluded by the calendars are returned as skipped.
+func (h *HTTPTransport) schedulePreviewHandler(c *gin.Context) {
+	req := struct {
+		Schedule  string    `json:"schedule"`
+		Timezone  string    `json:"timezone"`
+		Name      string    `json:"name"`
+		Calendars []string  `json:"calendars"`
+		Count     int       `json:"count"`
+		From      time.Time `json:"from"`
+	}{
+		Count: defaultPreviewCount,
+	}
+	if err := c.BindJSON(&req); err != nil {
+		c.Writer.WriteString(fmt.Sprintf("Unable to parse payload: %s.", err))
+		log.Error(err)
+		return
+	}
+	if req.From.IsZero() {
+		req.From = time.Now()
+	}
+
+	calendars, err := getCalendars(h.agent.Store, req.Calendars)
+	if err != nil {
+		c.AbortWithStatus(http.StatusBadRequest)
+		c.Writer.WriteString(err.Error())
+		return
+	}
+
+	preview, err := PreviewSchedule(req.Schedule, req.Timezone, req.Name, calendars, req.From, req.Count)
+	if err != nil {
+		c.AbortWithStatus(http.StatusBadRequest)
+		c.Writer.WriteString(fmt.Sprintf("Schedule contains invalid value: %s.", err))
+		return
+	}
+	renderJSON(c, http.StatusOK, preview)
+}
+
+func (h *HTTPTransport) calendarsHandler(c *gin.Context) {
+	calendars, err := h.agent.Store.GetCalendars()
+	if err != nil {
+		c.AbortWithError(http.StatusInternalServerError, err)
+		return
+	}
+	renderJSON(c, http.StatusOK, calendars)
+}
+
+func (h *HTTPTransport) calendarGetHandler(c *gin.Context) {
+	calendar, err := h.agent.Store.GetCalendar(c.Param("calendar"))
+	if err == buntdb.ErrNotFound {
+		c.AbortWithStatus(http.StatusNotFound)
+		return
+	}
+	if err != nil {
+		c.AbortWithError(http.StatusInternalServerError, err)
+		return
+	}
+	renderJSON(c, http.StatusOK, calendar)
+}
+
+// calendarCreateOrUpdateHandler stores a calendar, the days of the ICS
+// content and file are loaded in this node and replicated with the dates.
+func (h *HTTPTransport) calendarCreateOrUpdateHandler(c *gin.Context) {
+	var calendar Calendar
+	if err := c.BindJSON(&calendar); err != nil {
+		c.Writer.WriteString(fmt.Sprintf("Unable to parse payload: %s.", err))
+		log.Error(err)
+		return
+	}
+	if name := c.Param("calendar"); name != "" {
+		calendar.Name = name
+	}
+
+	if err := calendar.LoadICS(h.agent.config.CalendarDir); err != nil {
+		c.AbortWithStatus(http.StatusBadRequest)
+		c.Writer.WriteString(fmt.Sprintf("Unable to load ICS calendar: %s.", err))
+		return
+	}
+
+	if err := calendar.Validate(); err != nil {
+		c.AbortWithStatus(http.StatusBadRequest)
+		c.Writer.WriteString(fmt.Sprintf("Calendar contains invalid value: %s.", err))
+		return
+	}
+
+	// Call gRPC SetCalendar
+	if err := h.agent.GRPCClient.SetCalendar(&calendar); err != nil {
+		c.AbortWithStatus(http.StatusInternalServerError)
+		c.Writer.WriteString(status.Convert(err).Message())
+		return
+	}
+
+	renderJSON(c, http.StatusCreated, &calendar)
+}
+
+func (h *HTTPTransport) calendarDeleteHandler(c *gin.Context) {
+	// Call gRPC DeleteCalendar
+	calendar, err := h.agent.GRPCClient.DeleteCalendar(c.Param("calendar"))
+	if err != nil {
+		s := status.Convert(err)
+		switch s.Message() {
+		case ErrCalendarInUse.Error():
+			c.AbortWithStatus(http.StatusConflict)
+		case buntdb.ErrNotFound.Error():
+			c.AbortWithStatus(http.StatusNotFound)
+		default:
+			c.AbortWithStatus(http.StatusInternalServerError)
+		}
+		c.Writer.WriteString(s.Message())
+		return
+	}
+	renderJSON(c, http.StatusOK, calendar)
+}
+
 func (h *HTTPTransport) jobCreateOrUpdateHandler(c *gin.Context) {
 	// Init the Job object with defaults
 	job := Job{
@@ -244,8 +419,10 @@ func (h *HTTPTransport) jobCreateOrUpdateHandler(c *gin.Context) {
 	// Call gRPC SetJob
 	if err := h.agent.GRPCClient.SetJob(&job); err != nil {
 		s := status.Convert(err)
-		if s.Message() == ErrParentJobNotFound.Error() {
+		if s.Message() == ErrParentJobNotFound.Error() || s.Message() == ErrCalendarNotFound.Error() {
 			c.AbortWithStatus(http.StatusNotFound)
+		} else if s.Message() == ErrDependencyCycle.Error() || s.Message() == ErrBothParents.Error() {
+			c.AbortWithStatus(http.StatusBadRequest)
 		} else {
 			c.AbortWithStatus(http.StatusInternalServerError)
 		}
@@ -255,7 +432,7 @@ func (h *HTTPTransport) jobCreateOrUpdateHandler(c *gin.Context) {
 
 	// Immediately run the job if so requested
 	if _, exists := c.GetQuery("runoncreate"); exists {
-		h.agent.GRPCClient.RunJob(job.Name)
+		h.agent.GRPCClient.RunJob(job.Name, false)
 	}
 
 	c.Header("Location", fmt.Sprintf("%s/%s", c.Request.RequestURI, job.Name))
@@ -274,12 +451,24 @@ func (h *HTTPTransport) jobDeleteHandler(c *gin.Context) {
 	renderJSON(c, http.StatusOK, job)
 }
 
+// jobRunHandler runs a job, the "force" query parameter
+// runs it even outside its allowed windows.
 func (h *HTTPTransport) jobRunHandler(c *gin.Context) {
 	jobName := c.Param("job")
 
+	force, err := strconv.ParseBool(c.DefaultQuery("force", "false"))
+	if err != nil {
+		c.AbortWithError(http.StatusBadRequest, err)
+		return
+	}
+
 	// Call gRPC RunJob
-	job, err := h.agent.GRPCClient.RunJob(jobName)
+	job, err := h.agent.GRPCClient.RunJob(jobName, force)
 	if err != nil {
+		if status.Convert(err).Message() == ErrOutsideWindow.Error() {
+			c.AbortWithError(http.StatusConflict, err)
+			return
+		}
 		c.AbortWithError(http.StatusNotFound, err)
 		return
 	}
@@ -362,12 +551,150 @@ func (h *HTTPTransport) executionsHandler(c *gin.Context) {
 	renderJSON(c, http.StatusOK, executions)
 }
 
+// executionStopHandler stops a running execution, the user requesting it
+// is taken from the "user" query parameter.
+func (h *HTTPTransport) executionStopHandler(c *gin.Context) {
+	jobName := c.Param("job")
+	executionID := c.Param("id")
+
+	user := c.Query("user")
+	if user == "" {
+		c.AbortWithStatus(http.StatusBadRequest)
+		c.Writer.WriteString("A user is required to stop an execution.")
+		return
+	}
+
+	if err := h.agent.StopExecution(jobName, executionID, user); err != nil {
+		if err == ErrExecutionNotFound {
+			c.AbortWithError(http.StatusNotFound, err)
+			return
+		}
+		c.AbortWithError(http.StatusInternalServerError, err)
+		return
+	}
+
+	c.Status(http.StatusAccepted)
+}
+
+// executionLogHandler returns a range of the full output of an execution, starting at
+// the "offset" query parameter, negative offsets are relative to the end of the log.
+func (h *HTTPTransport) executionLogHandler(c *gin.Context) {
+	jobName := c.Param("job")
+	executionID := c.Param("id")
+
+	offset, err := strconv.ParseInt(c.DefaultQuery("offset", "0"), 10, 64)
+	if err != nil {
+		c.AbortWithError(http.StatusBadRequest, err)
+		return
+	}
+	limit, err := strconv.ParseInt(c.DefaultQuery("limit", "0"), 10, 64)
+	if err != nil {
+		c.AbortWithError(http.StatusBadRequest, err)
+		return
+	}
+
+	l, err := h.agent.GetExecutionLog(jobName, executionID, offset, limit)
+	if err != nil {
+		if err == ErrExecutionLogNotFound || err == buntdb.ErrNotFound {
+			c.AbortWithError(http.StatusNotFound, err)
+			return
+		}
+		c.AbortWithError(http.StatusInternalServerError, err)
+		return
+	}
+
+	renderJSON(c, http.StatusOK, l)
+}
+
+// executionStreamHandler streams the output of a running execution as Server-Sent Events,
+// "output" events carry output chunks and a final "done" event carries the finished execution.
+func (h *HTTPTransport) executionStreamHandler(c *gin.Context) {
+	jobName := c.Param("job")
+	executionID := c.Param("id")
+
+	err := h.agent.StreamExecution(c.Request.Context(), jobName, executionID, func(e *proto.Execution) error {
+		if e.FinishedAt != nil {
+			c.SSEvent("done", NewExecutionFromProto(e))
+		} else if len(e.Output) > 0 {
+			c.SSEvent("output", string(e.Output))
+		} else {
+			return nil
+		}
+		c.Writer.Flush()
+		return nil
+	})
+	if err != nil && !c.Writer.Written() {
+		if err == ErrExecutionNotFound {
+			c.AbortWithError(http.StatusNotFound, err)
+			return
+		}
+		c.AbortWithError(http.StatusInternalServerError, err)
+		return
+	}
+	if err != nil {
+		log.WithError(err).WithField("execution", executionID).Debug("api: execution stream ended")
+	}
+}
+
 type MId struct {
 	serf.Member
 
 	Id string `json:"id"`
 }
 
+// workflowRunHandler returns the status of every job of a workflow run,
+// the run started by the root job and the dependent jobs it triggered.
+func (h *HTTPTransport) workflowRunHandler(c *gin.Context) {
+	run, err := getWorkflowRun(h.agent.Store, c.Param("root"), c.Param("id"))
+	if err == buntdb.ErrNotFound || err == ErrWorkflowRunNotFound {
+		c.AbortWithStatus(http.StatusNotFound)
+		return
+	}
+	if err != nil {
+		c.AbortWithError(http.StatusInternalServerError, err)
+		return
+	}
+	renderJSON(c, http.StatusOK, run)
+}
+
+// graphHandler returns the dependency graph of the jobs, rooted at the job of
+// the "root" query parameter when set. The "format" query parameter selects
+// the JSON nodes and edges, or the DOT or Mermaid renderings.
+func (h *HTTPTransport) graphHandler(c *gin.Context) {
+	format := c.DefaultQuery("format", GraphFormatJSON)
+	switch format {
+	case GraphFormatJSON, GraphFormatDOT, GraphFormatMermaid:
+	default:
+		c.AbortWithError(http.StatusBadRequest, ErrWrongGraphFormat)
+		return
+	}
+
+	jobs, err := h.agent.Store.GetJobs(nil)
+	if err != nil {
+		c.AbortWithError(http.StatusInternalServerError, err)
+		return
+	}
+
+	graph, err := BuildGraph(jobs, c.Query("root"))
+	if err == buntdb.ErrNotFound {
+		c.AbortWithStatus(http.StatusNotFound)
+		return
+	}
+	if err != nil {
+		c.AbortWithError(http.StatusInternalServerError, err)
+		return
+	}
+
+	switch format {
+	case GraphFormatDOT:
+		c.Data(http.StatusOK, "text/vnd.graphviz; charset=utf-8", []byte(graph.DOT()))
+	case GraphFormatMermaid:
+		c.Data(http.StatusOK, "text/plain; charset=utf-8", []byte(graph.Mermaid()))
+	default:
+		renderJSON(c, http.StatusOK, graph)
+	}
+}
+
 func (h *HTTPTransport) membersHandler(c *gin.Context) {
 	mems := []*MId{}
 	for _, m := range h.agent.serf.Members() {
@@ -0,0 +1,312 @@
+package core
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"spiderjob/lib/extcron"
+	proto "spiderjob/lib/plugin/types"
+
+	"github.com/robfig/cron/v3"
+	"github.com/sirupsen/logrus"
+	"github.com/tidwall/buntdb"
+)
+
+// maxICSEventDays limits the days a single ICS event excludes.
+const maxICSEventDays = 366
+
+var (
+	// ErrCalendarNotFound is returned when a job references a calendar that doesn't exist.
+	ErrCalendarNotFound = errors.New("specified calendar not found")
+	// ErrCalendarInUse is returned when deleting a calendar referenced by jobs.
+	ErrCalendarInUse = errors.New("calendar is used by jobs, remove it from the jobs first")
+	// ErrWrongCalendar is returned when a calendar has invalid values.
+	ErrWrongCalendar = errors.New("invalid calendar, use dates like \"2006-01-02\"")
+	// ErrRecurringICSEvent is returned when an ICS calendar has recurring events, only their first day would be excluded.
+	ErrRecurringICSEvent = errors.New("recurring events are not supported, list every occurrence as an event")
+	// ErrICSFilesDisabled is returned when a calendar references an ICS file and no calendar-dir is configured.
+	ErrICSFilesDisabled = errors.New("ICS files are disabled, set calendar-dir or send the ICS content")
+	// ErrWrongICSFile is returned when the ICS file isn't a file name in the calendar-dir.
+	ErrWrongICSFile = errors.New("invalid ICS file, use the name of a file in calendar-dir")
+)
+
+// Calendar is a named list of days the jobs using it don't run,
+// like public holidays or company shutdown days.
+type Calendar struct {
+	// Calendar name, referenced by the jobs.
+	Name string `json:"name"`
+
+	// Excluded days, like "2006-01-02". Days are compared in the timezone of the job.
+	Dates []string `json:"dates"`
+
+	// iCalendar content the excluded days are loaded from, sent with the request.
+	// The events are added to the dates, only the dates are replicated.
+	ICS string `json:"ics,omitempty"`
+
+	// Name of an ICS file in the calendar-dir of the node receiving the
+	// request, loaded as the ICS content.
+	ICSFile string `json:"ics_file,omitempty"`
+}
+
+// NewCalendarFromProto maps a proto.Calendar to a Calendar.
+func NewCalendarFromProto(in *proto.Calendar) *Calendar {
+	return &Calendar{
+		Name:  in.Name,
+		Dates: in.Dates,
+	}
+}
+
+// ToProto returns the protobuf struct corresponding to the calendar.
+func (c *Calendar) ToProto() *proto.Calendar {
+	return &proto.Calendar{
+		Name:  c.Name,
+		Dates: c.Dates,
+	}
+}
+
+// Validate checks the calendar values.
+func (c *Calendar) Validate() error {
+	if c.Name == "" {
+		return fmt.Errorf("name can not be empty")
+	}
+
+	if valid, chr := isSlug(c.Name); !valid {
+		return fmt.Errorf("name contains illegal character '%s'", chr)
+	}
+
+	for _, d := range c.Dates {
+		if _, err := time.Parse(extcron.DateLayout, d); err != nil {
+			return fmt.Errorf("%s: %s", ErrWrongCalendar, err)
+		}
+	}
+
+	return nil
+}
+
+// LoadICS adds the days of the events of the ICS content and the ICS file,
+// looked up in dir, to the dates.
+func (c *Calendar) LoadICS(dir string) error {
+	var dates []string
+	if c.ICS != "" {
+		d, err := parseICS(strings.NewReader(c.ICS))
+		if err != nil {
+			return err
+		}
+		dates = append(dates, d...)
+	}
+
+	if c.ICSFile != "" {
+		if dir == "" {
+			return ErrICSFilesDisabled
+		}
+		if filepath.Base(c.ICSFile) != c.ICSFile || c.ICSFile == "." || c.ICSFile == ".." {
+			return ErrWrongICSFile
+		}
+		f, err := os.Open(filepath.Join(dir, c.ICSFile))
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+
+		d, err := parseICS(f)
+		if err != nil {
+			return fmt.Errorf("%s: %s", c.ICSFile, err)
+		}
+		dates = append(dates, d...)
+	}
+
+	if len(dates) == 0 {
+		c.ICS, c.ICSFile = "", ""
+		return nil
+	}
+
+	seen := make(map[string]bool)
+	var all []string
+	for _, d := range append(c.Dates, dates...) {
+		if !seen[d] {
+			seen[d] = true
+			all = append(all, d)
+		}
+	}
+	sort.Strings(all)
+
+	c.Dates = all
+	c.ICS, c.ICSFile = "", ""
+	return nil
+}
+
+// parseICS returns the days of the events of an iCalendar file.
+// Recurring events are rejected.
+func parseICS(r io.Reader) ([]string, error) {
+	var lines []string
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		line := strings.TrimRight(scanner.Text(), "\r")
+		// Unfold continuation lines
+		if len(lines) > 0 && (strings.HasPrefix(line, " ") || strings.HasPrefix(line, "\t")) {
+			lines[len(lines)-1] += line[1:]
+			continue
+		}
+		lines = append(lines, line)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	var dates []string
+	var start, end string
+	inEvent := false
+	for _, line := range lines {
+		sep := strings.Index(line, ":")
+		if sep < 0 {
+			continue
+		}
+		name, value := line[:sep], line[sep+1:]
+		if i := strings.Index(name, ";"); i >= 0 {
+			name = name[:i]
+		}
+
+		switch strings.ToUpper(name) {
+		case "BEGIN":
+			if strings.EqualFold(value, "VEVENT") {
+				inEvent, start, end = true, "", ""
+			}
+		case "DTSTART":
+			start = value
+		case "DTEND":
+			end = value
+		case "RRULE", "RDATE":
+			if inEvent {
+				return nil, ErrRecurringICSEvent
+			}
+		case "END":
+			if !inEvent || !strings.EqualFold(value, "VEVENT") {
+				continue
+			}
+			inEvent = false
+			days, err := icsEventDays(start, end)
+			if err != nil {
+				return nil, err
+			}
+			dates = append(dates, days...)
+		}
+	}
+
+	return dates, nil
+}
+
+// icsEventDays returns the days of an event, all-day events end the day before DTEND.
+func icsEventDays(start, end string) ([]string, error) {
+	if len(start) < 8 {
+		return nil, fmt.Errorf("invalid DTSTART %q", start)
+	}
+	first, err := time.Parse("20060102", start[:8])
+	if err != nil {
+		return nil, fmt.Errorf("invalid DTSTART %q", start)
+	}
+
+	last := first
+	if len(end) >= 8 {
+		if last, err = time.Parse("20060102", end[:8]); err != nil {
+			return nil, fmt.Errorf("invalid DTEND %q", end)
+		}
+		// The end is exclusive for dates and times at midnight
+		if len(end) == 8 || strings.HasPrefix(end[8:], "T000000") {
+			last = last.AddDate(0, 0, -1)
+		}
+	}
+
+	var days []string
+	for d := first; !d.After(last) && len(days) < maxICSEventDays; d = d.AddDate(0, 0, 1) {
+		days = append(days, d.Format(extcron.DateLayout))
+	}
+	if len(days) == 0 {
+		days = append(days, first.Format(extcron.DateLayout))
+	}
+	return days, nil
+}
+
+// getCalendars returns the calendars with the given names.
+func getCalendars(store Storage, names []string) ([]*Calendar, error) {
+	calendars := make([]*Calendar, 0, len(names))
+	for _, name := range names {
+		c, err := store.GetCalendar(name)
+		if err == buntdb.ErrNotFound {
+			return nil, fmt.Errorf("%s: %s", ErrCalendarNotFound, name)
+		}
+		if err != nil {
+			return nil, err
+		}
+		calendars = append(calendars, c)
+	}
+	return calendars, nil
+}
+
+// parseSchedule parses the schedule the way the scheduler runs it: with the
+// timezone applied, H tokens derived from the key and skipping the calendar days.
+func parseSchedule(schedule, timezone, key string, calendars []*Calendar) (cron.Schedule, error) {
+	s, err := extcron.ParseHashed(withTimezone(schedule, timezone), key)
+	if err != nil {
+		return nil, err
+	}
+	if len(calendars) == 0 {
+		return s, nil
+	}
+
+	// Descriptors don't get the timezone, compare the days in it anyway
+	var loc *time.Location
+	if timezone != "" {
+		if loc, err = time.LoadLocation(timezone); err != nil {
+			return nil, err
+		}
+	}
+
+	var days []string
+	for _, c := range calendars {
+		days = append(days, c.Dates...)
+	}
+	return extcron.Exclude(s, days, loc), nil
+}
+
+// cronSchedule returns the schedule the job runs on.
+func (j *Job) cronSchedule(store Storage) (cron.Schedule, error) {
+	calendars, err := getCalendars(store, j.Calendars)
+	if err != nil {
+		return nil, err
+	}
+	return parseSchedule(j.Schedule, j.Timezone, j.Name, calendars)
+}
+
+// rescheduleCalendarJobs adds again to the scheduler the jobs using the
+// calendar, so the next runs skip the new days. This only works on the leader.
+func (a *Agent) rescheduleCalendarJobs(name string) error {
+	jobs, err := a.Store.GetJobs(nil)
+	if err != nil {
+		return err
+	}
+
+	for _, job := range jobs {
+		for _, c := range job.Calendars {
+			if c != name {
+				continue
+			}
+			log.WithFields(logrus.Fields{
+				"job":      job.Name,
+				"calendar": name,
+			}).Debug("agent: Rescheduling job after calendar change")
+			job.Agent = a
+			if err := a.sched.AddJob(job); err != nil {
+				return err
+			}
+			break
+		}
+	}
+	return nil
+}
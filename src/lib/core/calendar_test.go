@@ -0,0 +1,92 @@
+package core
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+const testICS = `BEGIN:VCALENDAR
+BEGIN:VEVENT
+DTSTART;VALUE=DATE:20241225
+DTEND;VALUE=DATE:20241227
+END:VEVENT
+END:VCALENDAR
+`
+
+func TestCalendarLoadICS(t *testing.T) {
+	dir, err := ioutil.TempDir("", "spiderjob-calendars")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	if err := ioutil.WriteFile(filepath.Join(dir, "holidays.ics"), []byte(testICS), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name      string
+		calendar  Calendar
+		dir       string
+		want      []string
+		wantError string
+	}{
+		{
+			name:     "content",
+			calendar: Calendar{Dates: []string{"2024-12-26", "2024-01-01"}, ICS: testICS},
+			want:     []string{"2024-01-01", "2024-12-25", "2024-12-26"},
+		},
+		{
+			name:     "file",
+			calendar: Calendar{ICSFile: "holidays.ics"},
+			dir:      dir,
+			want:     []string{"2024-12-25", "2024-12-26"},
+		},
+		{
+			name:      "files disabled",
+			calendar:  Calendar{ICSFile: "holidays.ics"},
+			wantError: ErrICSFilesDisabled.Error(),
+		},
+		{
+			name:      "file outside the dir",
+			calendar:  Calendar{ICSFile: "../holidays.ics"},
+			dir:       dir,
+			wantError: ErrWrongICSFile.Error(),
+		},
+		{
+			name:      "missing file",
+			calendar:  Calendar{ICSFile: "missing.ics"},
+			dir:       dir,
+			wantError: "no such file",
+		},
+		{
+			name:      "recurring event",
+			calendar:  Calendar{ICS: strings.Replace(testICS, "END:VEVENT", "RRULE:FREQ=YEARLY\nEND:VEVENT", 1)},
+			wantError: ErrRecurringICSEvent.Error(),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := tt.calendar
+			err := c.LoadICS(tt.dir)
+			if tt.wantError != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantError) {
+					t.Fatalf("got error %v, want %q", err, tt.wantError)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if strings.Join(c.Dates, ",") != strings.Join(tt.want, ",") {
+				t.Fatalf("got dates %v, want %v", c.Dates, tt.want)
+			}
+			if c.ICS != "" || c.ICSFile != "" {
+				t.Fatal("ICS source kept after loading it")
+			}
+		})
+	}
+}
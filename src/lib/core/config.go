@@ -4,7 +4,6 @@ import (
 	"encoding/base64"
 	"errors"
 	"fmt"
-	"log"
 	"net"
 	"os"
 	"strconv"
@@ -104,6 +103,35 @@ type Config struct {
 	// use of persistence or state.
 	DevMode bool
 
+	// StorageBackend selects where servers keep jobs and executions.
+	// It could be (memory|disk). With "disk" the store is persisted under
+	// DataDir and servers don't need to replay the whole Raft state on restart.
+	StorageBackend string `mapstructure:"storage-backend"`
+
+	// CalendarDir is the directory the ICS files referenced by calendars
+	// are loaded from, empty means ICS files can't be used.
+	CalendarDir string `mapstructure:"calendar-dir"`
+
+	// RetentionMaxExecutions is the number of executions kept for jobs
+	// without a retention policy, 0 means no limit.
+	RetentionMaxExecutions int `mapstructure:"retention-max-executions"`
+
+	// RetentionMaxAge deletes the executions of jobs without a retention
+	// policy after this duration, 0 means no limit.
+	RetentionMaxAge time.Duration `mapstructure:"retention-max-age"`
+
+	// RetentionKeepLastFailure always keeps the last failed execution of
+	// jobs without a retention policy.
+	RetentionKeepLastFailure bool `mapstructure:"retention-keep-last-failure"`
+
+	// RetentionKeepLastSuccess always keeps the last successful execution of
+	// jobs without a retention policy.
+	RetentionKeepLastSuccess bool `mapstructure:"retention-keep-last-success"`
+
+	// RetentionInterval controls how often the leader deletes the executions
+	// not kept by the retention policies, 0 disables it.
+	RetentionInterval time.Duration `mapstructure:"retention-interval"`
+
 	// ReconcileInterval controls how often we reconcile the strongly
 	// consistent store with the Serf info. This is used to handle nodes
 	// that are force removed, as well as intermittent unavailability during
@@ -172,6 +200,12 @@ const (
 	DefaultRetryInterval time.Duration = time.Second * 30
 )
 
+// Supported values for the storage-backend option.
+const (
+	StorageBackendMemory = "memory"
+	StorageBackendDisk   = "disk"
+)
+
 // DefaultConfig returns a Config struct pointer with sensible
 // default settings.
 func DefaultConfig() *Config {
@@ -183,21 +217,26 @@ func DefaultConfig() *Config {
 	tags := map[string]string{}
 
 	return &Config{
-		NodeName:             hostname,
-		BindAddr:             fmt.Sprintf("{{ GetPrivateIP }}:%d", DefaultBindPort),
-		HTTPAddr:             ":8080",
-		Profile:              "lan",
-		LogLevel:             "info",
-		RPCPort:              DefaultRPCPort,
-		MailSubjectPrefix:    "[Dkron]",
-		Tags:                 tags,
-		DataDir:              "dkron.data",
-		Datacenter:           "dc1",
-		Region:               "global",
-		ReconcileInterval:    60 * time.Second,
-		RaftMultiplier:       1,
-		SerfReconnectTimeout: "24h",
-		UI:                   true,
+		NodeName:                 hostname,
+		BindAddr:                 fmt.Sprintf("{{ GetPrivateIP }}:%d", DefaultBindPort),
+		HTTPAddr:                 ":8080",
+		Profile:                  "lan",
+		LogLevel:                 "info",
+		RPCPort:                  DefaultRPCPort,
+		MailSubjectPrefix:        "[Dkron]",
+		Tags:                     tags,
+		DataDir:                  "dkron.data",
+		StorageBackend:           StorageBackendMemory,
+		Datacenter:               "dc1",
+		Region:                   "global",
+		ReconcileInterval:        60 * time.Second,
+		RetentionMaxExecutions:   100,
+		RetentionKeepLastFailure: true,
+		RetentionKeepLastSuccess: true,
+		RetentionInterval:        5 * time.Minute,
+		RaftMultiplier:           1,
+		SerfReconnectTimeout:     "24h",
+		UI:                       true,
 	}
 }
 
@@ -224,11 +263,20 @@ func ConfigFlagSet() *flag.FlagSet {
 	cmdFlags.Int("advertise-rpc-port", 0, "Use the value of rpc-port by default")
 	cmdFlags.Int("bootstrap-expect", 0, "Provides the number of expected servers in the datacenter. Either this value should not be provided or the value must agree with other servers in the cluster. When provided, Dkron waits until the specified number of servers are available and then bootstraps the cluster. This allows an initial leader to be elected automatically. This flag requires server mode.")
 	cmdFlags.String("data-dir", c.DataDir, "Specifies the directory to use for server-specific data, including the replicated log. By default, this is the top-level data-dir, like [/var/lib/dkron]")
+	cmdFlags.String("storage-backend", c.StorageBackend, "Storage backend used by servers to keep jobs and executions (memory|disk). The disk backend persists the store under data-dir so restarts don't depend on replaying the Raft history")
 	cmdFlags.String("datacenter", c.Datacenter, "Specifies the data center of the local agent. All members of a datacenter should share a local LAN connection.")
 	cmdFlags.String("region", c.Region, "Specifies the region the Dkron agent is a member of. A region typically maps to a geographic region, for example us, with potentially multiple zones, which map to datacenters such as us-west and us-east")
 	cmdFlags.String("serf-reconnect-timeout", c.SerfReconnectTimeout, "This is the amount of time to attempt to reconnect to a failed node before giving up and considering it completely gone. In Kubernetes, you might need this to about 5s, because there is no reason to try reconnects for default 24h value. Also Raft behaves oddly if node is not reaped and returned with same ID, but different IP. Format there: https://golang.org/pkg/time/#ParseDuration")
+	cmdFlags.String("calendar-dir", "", "Directory the ICS files referenced by calendars are loaded from, in the node receiving the request. Empty disables ICS files")
 	cmdFlags.Bool("ui", true, "Enable the web UI on this node. The node must be server.")
 
+	// Execution retention
+	cmdFlags.Int("retention-max-executions", c.RetentionMaxExecutions, "Number of executions kept for jobs without a retention policy, 0 means no limit")
+	cmdFlags.String("retention-max-age", "0s", "Executions of jobs without a retention policy are deleted after this duration, 0 means no limit")
+	cmdFlags.Bool("retention-keep-last-failure", c.RetentionKeepLastFailure, "Always keep the last failed execution of jobs without a retention policy")
+	cmdFlags.Bool("retention-keep-last-success", c.RetentionKeepLastSuccess, "Always keep the last successful execution of jobs without a retention policy")
+	cmdFlags.String("retention-interval", c.RetentionInterval.String(), "How often the leader deletes the executions not kept by the retention policies, 0 disables it")
+
 	// Notifications
 	cmdFlags.String("mail-host", "", "Mail server host address to use for notifications")
 	cmdFlags.Uint16("mail-port", 0, "Mail server port")
@@ -250,6 +298,19 @@ func ConfigFlagSet() *flag.FlagSet {
 	return cmdFlags
 }
 
+// RetentionPolicy returns the retention policy used for jobs without one.
+func (c *Config) RetentionPolicy() *RetentionPolicy {
+	p := &RetentionPolicy{
+		MaxExecutions:   c.RetentionMaxExecutions,
+		KeepLastFailure: c.RetentionKeepLastFailure,
+		KeepLastSuccess: c.RetentionKeepLastSuccess,
+	}
+	if c.RetentionMaxAge > 0 {
+		p.MaxAge = c.RetentionMaxAge.String()
+	}
+	return p
+}
+
 // normalizeAddrs normalizes Addresses and AdvertiseAddrs to always be
 // initialized and have sane defaults.
 func (c *Config) normalizeAddrs() error {
@@ -10,6 +10,21 @@ import (
 	"github.com/golang/protobuf/ptypes"
 )
 
+const (
+	// FailureExecutorMissing means the job executor is not present in the node.
+	FailureExecutorMissing = "executor-missing"
+	// FailureTimeout means the execution was stopped for exceeding the job timeout.
+	FailureTimeout = "timeout"
+	// FailureNodeLost means the connection with the node running the execution was lost.
+	FailureNodeLost = "node-lost"
+	// FailureNonZeroExit means the executed process exited with a non zero code.
+	FailureNonZeroExit = "non-zero-exit"
+	// FailureCancelled means the execution was stopped by a user.
+	FailureCancelled = "cancelled"
+	// FailureExecutorError means the executor reported any other error.
+	FailureExecutorError = "executor-error"
+)
+
 // Execution type holds all of the details of a specific Execution.
 type Execution struct {
 	// Id is the Key for this execution
@@ -38,14 +53,61 @@ type Execution struct {
 
 	// Retry attempt of this execution.
 	Attempt uint `json:"attempt,omitempty"`
+
+	// If this execution was stopped for exceeding the job timeout.
+	TimedOut bool `json:"timed_out,omitempty"`
+
+	// If this execution was stopped by a user.
+	Cancelled bool `json:"cancelled,omitempty"`
+
+	// Name of the user that stopped this execution.
+	CancelledBy string `json:"cancelled_by,omitempty"`
+
+	// Exit code of the process, for executors running processes.
+	ExitCode int `json:"exit_code"`
+
+	// Standard output, for executors able to split the output streams.
+	Stdout string `json:"stdout,omitempty"`
+
+	// Standard error, for executors able to split the output streams.
+	Stderr string `json:"stderr,omitempty"`
+
+	// Why this execution failed, one of the Failure* values.
+	FailureCategory string `json:"failure_category,omitempty"`
+
+	// Executor specific result fields.
+	Results map[string]string `json:"results,omitempty"`
+
+	// If this run was skipped instead of executed.
+	Skipped bool `json:"skipped,omitempty"`
+
+	// Why this run was skipped, one of the Skip* values.
+	SkipReason string `json:"skip_reason,omitempty"`
+
+	// Name of the parent job whose run triggered this execution.
+	TriggeredBy string `json:"triggered_by,omitempty"`
+
+	// Edge condition of the job met by the parent run, one of the TriggerOn* values.
+	TriggerEdge string `json:"trigger_edge,omitempty"`
+
+	// Workflow run this execution belongs to, shared by the executions of
+	// the dependency chain started by the same root execution.
+	WorkflowRun string `json:"workflow_run,omitempty"`
+
+	// Results of the parent run that triggered this execution, available
+	// to the executor config templates as .Parent.Outputs.
+	ParentOutputs map[string]string `json:"parent_outputs,omitempty"`
 }
 
 // NewExecution creates a new execution.
+// The execution starts its own workflow run, with the group as ID.
 func NewExecution(jobName string) *Execution {
+	group := time.Now().UnixNano()
 	return &Execution{
-		JobName: jobName,
-		Group:   time.Now().UnixNano(),
-		Attempt: 1,
+		JobName:     jobName,
+		Group:       group,
+		Attempt:     1,
+		WorkflowRun: strconv.FormatInt(group, 10),
 	}
 }
 
@@ -54,15 +116,29 @@ func NewExecutionFromProto(e *proto.Execution) *Execution {
 	startedAt, _ := ptypes.Timestamp(e.GetStartedAt())
 	finishedAt, _ := ptypes.Timestamp(e.GetFinishedAt())
 	return &Execution{
-		Id:         e.Key(),
-		JobName:    e.JobName,
-		Success:    e.Success,
-		Output:     string(e.Output),
-		NodeName:   e.NodeName,
-		Group:      e.Group,
-		Attempt:    uint(e.Attempt),
-		StartedAt:  startedAt,
-		FinishedAt: finishedAt,
+		Id:              e.Key(),
+		JobName:         e.JobName,
+		Success:         e.Success,
+		Output:          string(e.Output),
+		NodeName:        e.NodeName,
+		Group:           e.Group,
+		Attempt:         uint(e.Attempt),
+		StartedAt:       startedAt,
+		FinishedAt:      finishedAt,
+		TimedOut:        e.TimedOut,
+		Cancelled:       e.Cancelled,
+		CancelledBy:     e.CancelledBy,
+		ExitCode:        int(e.ExitCode),
+		Stdout:          string(e.Stdout),
+		Stderr:          string(e.Stderr),
+		FailureCategory: e.FailureCategory,
+		Results:         e.Results,
+		Skipped:         e.Skipped,
+		SkipReason:      e.SkipReason,
+		TriggeredBy:     e.TriggeredBy,
+		TriggerEdge:     e.TriggerEdge,
+		WorkflowRun:     e.WorkflowRun,
+		ParentOutputs:   e.ParentOutputs,
 	}
 }
 
@@ -72,15 +148,40 @@ func (e *Execution) ToProto() *proto.Execution {
 	startedAt, _ := ptypes.TimestampProto(e.StartedAt)
 	finishedAt, _ := ptypes.TimestampProto(e.FinishedAt)
 	return &proto.Execution{
-		JobName:    e.JobName,
-		Success:    e.Success,
-		Output:     []byte(e.Output),
-		NodeName:   e.NodeName,
-		Group:      e.Group,
-		Attempt:    uint32(e.Attempt),
-		StartedAt:  startedAt,
-		FinishedAt: finishedAt,
+		JobName:         e.JobName,
+		Success:         e.Success,
+		Output:          []byte(e.Output),
+		NodeName:        e.NodeName,
+		Group:           e.Group,
+		Attempt:         uint32(e.Attempt),
+		StartedAt:       startedAt,
+		FinishedAt:      finishedAt,
+		TimedOut:        e.TimedOut,
+		Cancelled:       e.Cancelled,
+		CancelledBy:     e.CancelledBy,
+		ExitCode:        int32(e.ExitCode),
+		Stdout:          []byte(e.Stdout),
+		Stderr:          []byte(e.Stderr),
+		FailureCategory: e.FailureCategory,
+		Results:         e.Results,
+		Skipped:         e.Skipped,
+		SkipReason:      e.SkipReason,
+		TriggeredBy:     e.TriggeredBy,
+		TriggerEdge:     e.TriggerEdge,
+		WorkflowRun:     e.WorkflowRun,
+		ParentOutputs:   e.ParentOutputs,
+	}
+}
+
+// ranExecutions returns the executions of the group that ran, without the skipped ones.
+func ranExecutions(group []*Execution) []*Execution {
+	var ran []*Execution
+	for _, e := range group {
+		if !e.Skipped {
+			ran = append(ran, e)
+		}
 	}
+	return ran
 }
 
 // Key wil generate the execution Id for an execution.
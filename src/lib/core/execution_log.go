@@ -0,0 +1,168 @@
+package core
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+const (
+	// maxLogChunkSize limits how much log data is returned in a single read.
+	maxLogChunkSize = 1024 * 1024
+
+	logFileExt = ".log"
+)
+
+var (
+	// ErrExecutionLogNotFound is returned when the log of an execution is not in this node.
+	ErrExecutionLogNotFound = errors.New("log store: execution log not found")
+	// ErrInvalidLogName is returned when the job name or execution id can't be used as a file name.
+	ErrInvalidLogName = errors.New("log store: invalid job name or execution id")
+)
+
+// ExecutionLog is a range of the full output of an execution.
+type ExecutionLog struct {
+	// Offset of the first returned byte.
+	Offset int64 `json:"offset"`
+
+	// Offset to use to continue reading the log.
+	NextOffset int64 `json:"next_offset"`
+
+	// Size of the whole log when it was read.
+	Size int64 `json:"size"`
+
+	// Log data in the requested range.
+	Data string `json:"data"`
+}
+
+// LogStore keeps the full output of the executions run in this node
+// outside of the replicated state, in one append only file per execution.
+type LogStore struct {
+	dir string
+
+	// If dir is removed when the store is closed.
+	temporary bool
+}
+
+// NewLogStore creates a log store saving the logs in dir.
+func NewLogStore(dir string) (*LogStore, error) {
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return nil, err
+	}
+	return &LogStore{dir: dir}, nil
+}
+
+// NewTempLogStore creates a log store in a temporary directory
+// that is removed when the store is closed.
+func NewTempLogStore() (*LogStore, error) {
+	dir, err := ioutil.TempDir("", "spiderjob-logs")
+	if err != nil {
+		return nil, err
+	}
+	return &LogStore{dir: dir, temporary: true}, nil
+}
+
+// Close removes the directory of temporary stores.
+func (l *LogStore) Close() error {
+	if l.temporary {
+		return os.RemoveAll(l.dir)
+	}
+	return nil
+}
+
+func (l *LogStore) path(jobName, executionID string) (string, error) {
+	for _, n := range []string{jobName, executionID} {
+		if n == "" || n == "." || n == ".." || strings.ContainsAny(n, `/\`) {
+			return "", ErrInvalidLogName
+		}
+	}
+	return filepath.Join(l.dir, jobName, executionID+logFileExt), nil
+}
+
+// Writer returns a writer appending chunks to the log of an execution.
+func (l *LogStore) Writer(jobName, executionID string) (io.WriteCloser, error) {
+	p, err := l.path(jobName, executionID)
+	if err != nil {
+		return nil, err
+	}
+	if err := os.MkdirAll(filepath.Dir(p), 0700); err != nil {
+		return nil, err
+	}
+	return os.OpenFile(p, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0600)
+}
+
+// Read returns up to limit bytes of the log of an execution starting at offset,
+// negative offsets are relative to the end of the log.
+func (l *LogStore) Read(jobName, executionID string, offset, limit int64) (*ExecutionLog, error) {
+	p, err := l.path(jobName, executionID)
+	if err != nil {
+		return nil, err
+	}
+	f, err := os.Open(p)
+	if os.IsNotExist(err) {
+		return nil, ErrExecutionLogNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	fi, err := f.Stat()
+	if err != nil {
+		return nil, err
+	}
+	size := fi.Size()
+
+	if offset < 0 {
+		offset += size
+		if offset < 0 {
+			offset = 0
+		}
+	}
+	if offset > size {
+		offset = size
+	}
+	if limit <= 0 || limit > maxLogChunkSize {
+		limit = maxLogChunkSize
+	}
+	if offset+limit > size {
+		limit = size - offset
+	}
+
+	data := make([]byte, limit)
+	n, err := f.ReadAt(data, offset)
+	if err != nil && err != io.EOF {
+		return nil, fmt.Errorf("log store: error reading %s: %s", p, err)
+	}
+
+	return &ExecutionLog{
+		Offset:     offset,
+		NextOffset: offset + int64(n),
+		Size:       size,
+		Data:       string(data[:n]),
+	}, nil
+}
+
+// Delete removes the log of an execution.
+func (l *LogStore) Delete(jobName, executionID string) error {
+	p, err := l.path(jobName, executionID)
+	if err != nil {
+		return err
+	}
+	if err := os.Remove(p); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	return nil
+}
+
+// DeleteJob removes the logs of every execution of the job.
+func (l *LogStore) DeleteJob(jobName string) error {
+	if jobName == "" || jobName == "." || jobName == ".." || strings.ContainsAny(jobName, `/\`) {
+		return ErrInvalidLogName
+	}
+	return os.RemoveAll(filepath.Join(l.dir, jobName))
+}
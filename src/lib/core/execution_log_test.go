@@ -0,0 +1,59 @@
+package core
+
+import (
+	"os"
+	"testing"
+)
+
+func TestLogStore(t *testing.T) {
+	l, err := NewTempLogStore()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, id := range []string{"1-node1", "2-node1"} {
+		w, err := l.Writer("job1", id)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := w.Write([]byte("output of " + id)); err != nil {
+			t.Fatal(err)
+		}
+		w.Close()
+	}
+
+	got, err := l.Read("job1", "1-node1", -6, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.Data != "-node1" || got.Size != int64(len("output of 1-node1")) {
+		t.Fatalf("got log %+v", got)
+	}
+
+	if err := l.Delete("job1", "1-node1"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := l.Read("job1", "1-node1", 0, 0); err != ErrExecutionLogNotFound {
+		t.Fatalf("got error %v, want %v", err, ErrExecutionLogNotFound)
+	}
+	if _, err := l.Read("job1", "2-node1", 0, 0); err != nil {
+		t.Fatalf("log of another execution deleted: %s", err)
+	}
+
+	if err := l.DeleteJob("job1"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := l.Read("job1", "2-node1", 0, 0); err != ErrExecutionLogNotFound {
+		t.Fatalf("got error %v, want %v", err, ErrExecutionLogNotFound)
+	}
+	if err := l.DeleteJob(".."); err != ErrInvalidLogName {
+		t.Fatalf("got error %v, want %v", err, ErrInvalidLogName)
+	}
+
+	if err := l.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := os.Stat(l.dir); !os.IsNotExist(err) {
+		t.Fatalf("temporary log dir not removed: %v", err)
+	}
+}
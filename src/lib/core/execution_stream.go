@@ -0,0 +1,122 @@
+package core
+
+import (
+	"sync"
+
+	proto "spiderjob/lib/plugin/types"
+
+	"github.com/armon/circbuf"
+	pb "github.com/golang/protobuf/proto"
+)
+
+// subscriberBufSize is the number of updates buffered for each subscriber,
+// slower subscribers are dropped so they can't block the execution stream.
+const subscriberBufSize = 256
+
+// executionBroker fans out the updates of the executions streamed
+// to this server to the clients following them.
+type executionBroker struct {
+	mu      sync.Mutex
+	streams map[string]*executionStream
+}
+
+// executionStream keeps the subscribers of an execution and the last part
+// of its output, sent first to the clients subscribing after it started.
+type executionStream struct {
+	subs      map[chan *proto.Execution]struct{}
+	execution *proto.Execution
+	output    *circbuf.Buffer
+}
+
+func newExecutionBroker() *executionBroker {
+	return &executionBroker{
+		streams: make(map[string]*executionStream),
+	}
+}
+
+// open starts accepting subscribers for an execution.
+func (b *executionBroker) open(key string) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if _, ok := b.streams[key]; !ok {
+		output, _ := circbuf.NewBuffer(maxBufSize)
+		b.streams[key] = &executionStream{
+			subs:   make(map[chan *proto.Execution]struct{}),
+			output: output,
+		}
+	}
+}
+
+// close ends the streams of all the subscribers of an execution.
+func (b *executionBroker) close(key string) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if es, ok := b.streams[key]; ok {
+		for ch := range es.subs {
+			close(ch)
+		}
+	}
+	delete(b.streams, key)
+}
+
+// subscribe returns a channel receiving the updates of an execution, closed
+// when the execution finishes, and a function to stop receiving them.
+// The first update carries the output sent before subscribing, if any.
+// It returns false if the execution is not streamed to this server.
+func (b *executionBroker) subscribe(key string) (<-chan *proto.Execution, func(), bool) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	es, ok := b.streams[key]
+	if !ok {
+		return nil, nil, false
+	}
+	ch := make(chan *proto.Execution, subscriberBufSize)
+	if es.execution != nil && es.output.TotalWritten() > 0 {
+		current := pb.Clone(es.execution).(*proto.Execution)
+		current.Output = es.output.Bytes()
+		ch <- current
+	}
+	es.subs[ch] = struct{}{}
+
+	unsubscribe := func() {
+		b.mu.Lock()
+		defer b.mu.Unlock()
+
+		if es, ok := b.streams[key]; ok {
+			if _, ok := es.subs[ch]; ok {
+				delete(es.subs, ch)
+				close(ch)
+			}
+		}
+	}
+	return ch, unsubscribe, true
+}
+
+// publish sends an update of an execution to its subscribers.
+func (b *executionBroker) publish(key string, execution *proto.Execution) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	es, ok := b.streams[key]
+	if !ok {
+		return
+	}
+	es.execution = execution
+	// The finished execution carries the whole output, not a chunk
+	if execution.FinishedAt == nil {
+		es.output.Write(execution.Output)
+	}
+
+	for ch := range es.subs {
+		select {
+		case ch <- execution:
+		default:
+			log.WithField("execution", key).Warn("broker: dropping slow execution stream subscriber")
+			delete(es.subs, ch)
+			close(ch)
+		}
+	}
+}
@@ -0,0 +1,73 @@
+package core
+
+import (
+	"testing"
+
+	proto "spiderjob/lib/plugin/types"
+
+	"github.com/golang/protobuf/ptypes"
+)
+
+func TestExecutionBrokerLateSubscriber(t *testing.T) {
+	b := newExecutionBroker()
+	if _, _, ok := b.subscribe("ex1"); ok {
+		t.Fatal("subscribed to an execution not streamed")
+	}
+
+	b.open("ex1")
+	defer b.close("ex1")
+
+	// The first update doesn't carry output
+	b.publish("ex1", &proto.Execution{JobName: "job1"})
+	early, unsubscribe, ok := b.subscribe("ex1")
+	if !ok {
+		t.Fatal("execution not streamed")
+	}
+	defer unsubscribe()
+	if len(early) != 0 {
+		t.Fatalf("got %d updates before any output, want 0", len(early))
+	}
+
+	b.publish("ex1", &proto.Execution{JobName: "job1", Output: []byte("first ")})
+	b.publish("ex1", &proto.Execution{JobName: "job1", Output: []byte("second ")})
+
+	late, unsubscribe, ok := b.subscribe("ex1")
+	if !ok {
+		t.Fatal("execution not streamed")
+	}
+	defer unsubscribe()
+	b.publish("ex1", &proto.Execution{JobName: "job1", Output: []byte("third")})
+
+	tests := []struct {
+		name string
+		ch   <-chan *proto.Execution
+		want []string
+	}{
+		{name: "early subscriber", ch: early, want: []string{"first ", "second ", "third"}},
+		{name: "late subscriber", ch: late, want: []string{"first second ", "third"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if len(tt.ch) != len(tt.want) {
+				t.Fatalf("got %d updates, want %d", len(tt.ch), len(tt.want))
+			}
+			for _, want := range tt.want {
+				e := <-tt.ch
+				if string(e.Output) != want {
+					t.Fatalf("got output %q, want %q", e.Output, want)
+				}
+				if e.JobName != "job1" {
+					t.Fatalf("got job %q, want job1", e.JobName)
+				}
+			}
+		})
+	}
+
+	// The final execution carries the whole output and isn't added to it
+	b.publish("ex1", &proto.Execution{JobName: "job1", Output: []byte("first second third"), FinishedAt: ptypes.TimestampNow()})
+	last, unsubscribe, _ := b.subscribe("ex1")
+	defer unsubscribe()
+	if e := <-last; string(e.Output) != "first second third" {
+		t.Fatalf("got output %q after the execution finished", e.Output)
+	}
+}
@@ -0,0 +1,323 @@
+package core
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	proto "spiderjob/lib/plugin/types"
+
+	"github.com/golang/protobuf/ptypes"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	// FanInAllSucceeded runs the job once the last run of every parent succeeded, the default.
+	FanInAllSucceeded = "all-succeeded"
+	// FanInAnySucceeded runs the job once a run of any parent succeeded.
+	FanInAnySucceeded = "any-succeeded"
+	// FanInAllFinished runs the job once every parent finished a run, successful or not.
+	FanInAllFinished = "all-finished"
+)
+
+var (
+	// ErrWrongFanIn is returned when the fan-in condition of a job is not valid.
+	ErrWrongFanIn = errors.New("invalid fan-in value, use \"all-succeeded\", \"any-succeeded\" or \"all-finished\"")
+	// ErrDependencyCycle is returned when the parents of a job depend on the job.
+	ErrDependencyCycle = errors.New("the job parents can not depend on the job")
+	// ErrBothParents is returned when a job sets both a parent job and parent jobs.
+	ErrBothParents = errors.New("the job can not have both a parent job and parent jobs")
+)
+
+// UpstreamRun is a finished run of a parent job.
+type UpstreamRun struct {
+	// Execution group of the run.
+	Group int64 `json:"group"`
+
+	// If every execution of the run succeeded.
+	Success bool `json:"success"`
+
+	// When the last execution of the run finished.
+	FinishedAt time.Time `json:"finished_at"`
+
+	// Results of the executions of the run.
+	Outputs map[string]string `json:"outputs"`
+}
+
+// JoinResult is the outcome of recording a parent run in the join state of a job.
+type JoinResult struct {
+	// If the parent runs meet the fan-in condition of the job.
+	Ready bool
+
+	// Merged outputs of the parent runs, when ready.
+	Outputs map[string]string
+}
+
+// NewUpstreamRunFromProto maps a proto.UpstreamRun to an UpstreamRun.
+func NewUpstreamRunFromProto(in *proto.UpstreamRun) *UpstreamRun {
+	finishedAt, _ := ptypes.Timestamp(in.GetFinishedAt())
+	return &UpstreamRun{
+		Group:      in.Group,
+		Success:    in.Success,
+		FinishedAt: finishedAt,
+		Outputs:    in.Outputs,
+	}
+}
+
+// ToProto returns the protobuf struct corresponding to the parent run.
+func (r *UpstreamRun) ToProto() *proto.UpstreamRun {
+	finishedAt, _ := ptypes.TimestampProto(r.FinishedAt)
+	return &proto.UpstreamRun{
+		Group:      r.Group,
+		Success:    r.Success,
+		FinishedAt: finishedAt,
+		Outputs:    r.Outputs,
+	}
+}
+
+// JoinState holds the parent runs finished since a job with parent jobs
+// last ran, it's stored in the replicated state so it survives a leader change.
+type JoinState struct {
+	// Name of the job waiting for its parents.
+	JobName string `json:"job_name"`
+
+	// Last finished run of every parent, by parent name.
+	Parents map[string]*UpstreamRun `json:"parents"`
+
+	// Group of the last run recorded of every parent, kept when the job runs
+	// so a run reported by several executions is only recorded once.
+	LastGroups map[string]int64 `json:"last_groups"`
+}
+
+// NewJoinStateFromProto maps a proto.JoinState to a JoinState.
+func NewJoinStateFromProto(in *proto.JoinState) *JoinState {
+	js := &JoinState{
+		JobName:    in.JobName,
+		Parents:    make(map[string]*UpstreamRun, len(in.Parents)),
+		LastGroups: make(map[string]int64, len(in.LastGroups)),
+	}
+	for name, g := range in.LastGroups {
+		js.LastGroups[name] = g
+	}
+	for name, r := range in.Parents {
+		js.Parents[name] = NewUpstreamRunFromProto(r)
+	}
+	return js
+}
+
+// ToProto returns the protobuf struct corresponding to the join state.
+func (js *JoinState) ToProto() *proto.JoinState {
+	parents := make(map[string]*proto.UpstreamRun, len(js.Parents))
+	for name, r := range js.Parents {
+		parents[name] = r.ToProto()
+	}
+	return &proto.JoinState{
+		JobName:    js.JobName,
+		Parents:    parents,
+		LastGroups: js.LastGroups,
+	}
+}
+
+// isDependent returns if the job runs after other jobs instead of on a schedule.
+func (j *Job) isDependent() bool {
+	return j.ParentJob != "" || len(j.ParentJobs) > 0
+}
+
+// hasParent returns if the job is one of the parent jobs.
+func (j *Job) hasParent(name string) bool {
+	for _, p := range j.ParentJobs {
+		if p == name {
+			return true
+		}
+	}
+	return false
+}
+
+// joinReady returns if the parent runs meet the fan-in condition of the job.
+func (j *Job) joinReady(state *JoinState) bool {
+	switch j.FanIn {
+	case FanInAnySucceeded:
+		for _, p := range j.ParentJobs {
+			if r, ok := state.Parents[p]; ok && r.Success {
+				return true
+			}
+		}
+		return false
+	case FanInAllFinished:
+		for _, p := range j.ParentJobs {
+			if _, ok := state.Parents[p]; !ok {
+				return false
+			}
+		}
+		return true
+	default:
+		for _, p := range j.ParentJobs {
+			if r, ok := state.Parents[p]; !ok || !r.Success {
+				return false
+			}
+		}
+		return true
+	}
+}
+
+// checkDependencyCycle returns an error if setting the job creates
+// a cycle in the graph of parents of the jobs.
+func checkDependencyCycle(job *Job, jobs []*Job) error {
+	parents := make(map[string][]string, len(jobs)+1)
+	for _, j := range jobs {
+		parents[j.Name] = j.parents()
+	}
+	parents[job.Name] = job.parents()
+
+	visited := make(map[string]bool)
+	var visit func(name string) bool
+	visit = func(name string) bool {
+		if name == job.Name {
+			return true
+		}
+		if visited[name] {
+			return false
+		}
+		visited[name] = true
+		for _, p := range parents[name] {
+			if visit(p) {
+				return true
+			}
+		}
+		return false
+	}
+
+	for _, p := range parents[job.Name] {
+		if visit(p) {
+			log.WithField("job", job.Name).WithField("parent", p).Debug("store: Dependency cycle found")
+			return ErrDependencyCycle
+		}
+	}
+	return nil
+}
+
+// parents returns the names of all the jobs the job depends on.
+func (j *Job) parents() []string {
+	if j.ParentJob != "" {
+		return append([]string{j.ParentJob}, j.ParentJobs...)
+	}
+	return j.ParentJobs
+}
+
+// joinOutputs merges the outputs of the parent runs of the join,
+// the parents listed last override the outputs of the first ones.
+func joinOutputs(job *Job, state *JoinState) map[string]string {
+	outputs := make(map[string]string)
+	for _, p := range job.ParentJobs {
+		if r, ok := state.Parents[p]; ok {
+			for name, value := range r.Outputs {
+				outputs[name] = value
+			}
+		}
+	}
+	return outputs
+}
+
+// groupSucceeded returns if every execution of the group succeeded.
+func groupSucceeded(group []*Execution) bool {
+	for _, e := range group {
+		if !e.Success {
+			return false
+		}
+	}
+	return len(group) > 0
+}
+
+// groupFinished returns if every execution of the group finished and
+// the time the last one finished.
+func groupFinished(group []*Execution) (bool, time.Time) {
+	var last time.Time
+	for _, e := range group {
+		if e.FinishedAt.IsZero() {
+			return false, time.Time{}
+		}
+		if e.FinishedAt.After(last) {
+			last = e.FinishedAt
+		}
+	}
+	return len(group) > 0, last
+}
+
+// runFanInChildren records the finished run of the parent in the join state of
+// the jobs depending on it, and runs the ones meeting their fan-in condition.
+// The join state is merged by the FSM so parents finishing at once don't race.
+// This only works on the leader.
+func (a *Agent) runFanInChildren(parent *Job, group []*Execution) error {
+	group = ranExecutions(group)
+	finished, finishedAt := groupFinished(group)
+	if !finished {
+		return nil
+	}
+
+	jobs, err := a.Store.GetJobs(nil)
+	if err != nil {
+		return err
+	}
+
+	run := &UpstreamRun{
+		Group:      group[0].Group,
+		Success:    groupSucceeded(group),
+		FinishedAt: finishedAt,
+		Outputs:    groupResults(group),
+	}
+	for _, child := range jobs {
+		if !child.hasParent(parent.Name) {
+			continue
+		}
+		child.Agent = a
+		runnable, reason := child.isRunnable()
+
+		cmd, err := Encode(JoinParentRunType, &proto.JoinParentRunRequest{
+			JobName:  child.Name,
+			Parent:   parent.Name,
+			Run:      run.ToProto(),
+			Runnable: runnable,
+		})
+		if err != nil {
+			return err
+		}
+		af := a.raft.Apply(cmd, raftTimeout)
+		if err := af.Error(); err != nil {
+			return err
+		}
+		res := af.Response()
+		if err, ok := res.(error); ok {
+			return err
+		}
+		join, ok := res.(*JoinResult)
+		if !ok {
+			return fmt.Errorf("agent: Error wrong response from apply in runFanInChildren: %v", res)
+		}
+		if !join.Ready {
+			continue
+		}
+
+		fields := logrus.Fields{
+			"job":    child.Name,
+			"parent": parent.Name,
+			"fan_in": child.FanIn,
+		}
+		if !runnable {
+			if reason != "" {
+				a.recordSkipped(child, reason)
+			}
+			// The join is kept, the job runs on the next parent run
+			log.WithFields(fields).Info("agent: Parent jobs finished but the job can't run now, waiting for the next parent run")
+			continue
+		}
+
+		log.WithFields(fields).Debug("agent: Running job with parent jobs")
+		ex := NewExecution(child.Name)
+		ex.TriggeredBy = parent.Name
+		ex.WorkflowRun = workflowRunID(group)
+		ex.ParentOutputs = join.Outputs
+		child.RunExecution(ex)
+	}
+
+	return nil
+}
@@ -24,6 +24,18 @@ const (
 	// ExecutionDoneType is the command to perform the logic needed once an exeuction
 	// is done.
 	ExecutionDoneType
+	// SetPendingRetryType is the command used to store a retry scheduled by the leader.
+	SetPendingRetryType
+	// DeletePendingRetryType is the command used to delete a retry once it runs.
+	DeletePendingRetryType
+	// SetCalendarType is the command used to store a calendar.
+	SetCalendarType
+	// DeleteCalendarType is the command used to delete a calendar.
+	DeleteCalendarType
+	// JoinParentRunType is the command used to record a parent run in the join state of a job.
+	JoinParentRunType
+	// TriggerDependentRunType is the command used to record the parent run triggering a dependent job.
+	TriggerDependentRunType
 )
 
 // LogApplier is the definition of a function that can apply a Raft log
@@ -36,20 +48,44 @@ type LogAppliers map[MessageType]LogApplier
 type dkronFSM struct {
 	store Storage
 
+	// applied is the index of the last log entry applied to the store,
+	// a persisted store already holds the entries Raft replays on start.
+	applied uint64
+
 	// proAppliers holds the set of pro only LogAppliers
 	proAppliers LogAppliers
 }
 
 // NewFSM is used to construct a new FSM with a blank state
 func newFSM(store Storage, logAppliers LogAppliers) *dkronFSM {
+	applied, err := store.GetAppliedIndex()
+	if err != nil {
+		log.WithError(err).Error("fsm: Error getting the last applied index, replaying all entries")
+	}
 	return &dkronFSM{
 		store:       store,
+		applied:     applied,
 		proAppliers: logAppliers,
 	}
 }
 
-// Apply applies a Raft log entry to the key-value store.
+// Apply applies a Raft log entry to the key-value store, skipping the
+// entries already applied to the store before a restart.
 func (d *dkronFSM) Apply(l *raft.Log) interface{} {
+	if l.Index <= d.applied {
+		log.WithField("index", l.Index).Debug("fsm: skipping command already applied")
+		return nil
+	}
+
+	res := d.apply(l)
+	if err := d.store.SetAppliedIndex(l.Index); err != nil {
+		log.WithError(err).WithField("index", l.Index).Error("fsm: Error storing the last applied index")
+	}
+	d.applied = l.Index
+	return res
+}
+
+func (d *dkronFSM) apply(l *raft.Log) interface{} {
 	buf := l.Data
 	msgType := MessageType(buf[0])
 
@@ -64,6 +100,20 @@ func (d *dkronFSM) Apply(l *raft.Log) interface{} {
 		return d.applyExecutionDone(buf[1:])
 	case SetExecutionType:
 		return d.applySetExecution(buf[1:])
+	case DeleteExecutionsType:
+		return d.applyDeleteExecutions(buf[1:])
+	case SetPendingRetryType:
+		return d.applySetPendingRetry(buf[1:])
+	case DeletePendingRetryType:
+		return d.applyDeletePendingRetry(buf[1:])
+	case SetCalendarType:
+		return d.applySetCalendar(buf[1:])
+	case DeleteCalendarType:
+		return d.applyDeleteCalendar(buf[1:])
+	case JoinParentRunType:
+		return d.applyJoinParentRun(buf[1:])
+	case TriggerDependentRunType:
+		return d.applyTriggerDependentRun(buf[1:])
 	}
 
 	// Check enterprise only message types.
@@ -126,6 +176,75 @@ func (d *dkronFSM) applySetExecution(buf []byte) interface{} {
 	return key
 }
 
+func (d *dkronFSM) applyDeleteExecutions(buf []byte) interface{} {
+	var der dkronpb.DeleteExecutionsRequest
+	if err := proto.Unmarshal(buf, &der); err != nil {
+		return err
+	}
+	return d.store.DeleteExecutions(der.GetJobName(), der.GetExecutionIds())
+}
+
+func (d *dkronFSM) applySetPendingRetry(buf []byte) interface{} {
+	var pr dkronpb.PendingRetry
+	if err := proto.Unmarshal(buf, &pr); err != nil {
+		return err
+	}
+	return d.store.SetPendingRetry(NewPendingRetryFromProto(&pr))
+}
+
+func (d *dkronFSM) applyDeletePendingRetry(buf []byte) interface{} {
+	var dpr dkronpb.DeletePendingRetryRequest
+	if err := proto.Unmarshal(buf, &dpr); err != nil {
+		return err
+	}
+	return d.store.DeletePendingRetry(dpr.GetJobName(), dpr.GetExecutionId())
+}
+
+func (d *dkronFSM) applySetCalendar(buf []byte) interface{} {
+	var pc dkronpb.Calendar
+	if err := proto.Unmarshal(buf, &pc); err != nil {
+		return err
+	}
+	return d.store.SetCalendar(NewCalendarFromProto(&pc))
+}
+
+func (d *dkronFSM) applyDeleteCalendar(buf []byte) interface{} {
+	var dcr dkronpb.DeleteCalendarRequest
+	if err := proto.Unmarshal(buf, &dcr); err != nil {
+		return err
+	}
+	calendar, err := d.store.DeleteCalendar(dcr.GetName())
+	if err != nil {
+		return err
+	}
+	return calendar
+}
+
+func (d *dkronFSM) applyJoinParentRun(buf []byte) interface{} {
+	var jpr dkronpb.JoinParentRunRequest
+	if err := proto.Unmarshal(buf, &jpr); err != nil {
+		return err
+	}
+	run := NewUpstreamRunFromProto(jpr.GetRun())
+	res, err := d.store.JoinParentRun(jpr.GetJobName(), jpr.GetParent(), run, jpr.GetRunnable())
+	if err != nil {
+		return err
+	}
+	return res
+}
+
+func (d *dkronFSM) applyTriggerDependentRun(buf []byte) interface{} {
+	var jpr dkronpb.JoinParentRunRequest
+	if err := proto.Unmarshal(buf, &jpr); err != nil {
+		return err
+	}
+	triggered, err := d.store.TriggerDependentRun(jpr.GetJobName(), jpr.GetParent(), jpr.GetRun().GetGroup())
+	if err != nil {
+		return err
+	}
+	return triggered
+}
+
 // Snapshot returns a snapshot of the key-value store. We wrap
 // the things we need in dkronSnapshot and then send that over to Persist.
 // Persist encodes the needed data from dkronSnapshot and transport it to
@@ -138,7 +257,16 @@ func (d *dkronFSM) Snapshot() (raft.FSMSnapshot, error) {
 // Restore stores the key-value store to a previous state.
 func (d *dkronFSM) Restore(r io.ReadCloser) error {
 	defer r.Close()
-	return d.store.Restore(r)
+	if err := d.store.Restore(r); err != nil {
+		return err
+	}
+	// The snapshot holds the index of the last entry it contains
+	applied, err := d.store.GetAppliedIndex()
+	if err != nil {
+		return err
+	}
+	d.applied = applied
+	return nil
 }
 
 type dkronSnapshot struct {
@@ -0,0 +1,75 @@
+package core
+
+import (
+	"io/ioutil"
+	"os"
+	"testing"
+	"time"
+
+	proto "spiderjob/lib/plugin/types"
+
+	"github.com/hashicorp/raft"
+)
+
+// TestFSMReplayOnRestart checks the entries Raft replays on start aren't
+// applied again to a disk store that already holds them.
+func TestFSMReplayOnRestart(t *testing.T) {
+	dir, err := ioutil.TempDir("", "spiderjob-store")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	setJob, err := Encode(SetJobType, testJob("job1").ToProto())
+	if err != nil {
+		t.Fatal(err)
+	}
+	ex := testExecution("job1", time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC), true)
+	executionDone, err := Encode(ExecutionDoneType, &proto.ExecutionDoneRequest{Execution: ex.ToProto()})
+	if err != nil {
+		t.Fatal(err)
+	}
+	logs := []*raft.Log{
+		{Index: 1, Data: setJob},
+		{Index: 2, Data: executionDone},
+	}
+
+	apply := func(logs []*raft.Log) {
+		t.Helper()
+		s, err := NewFileStore(dir)
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer s.Shutdown()
+
+		fsm := newFSM(s, nil)
+		for _, l := range logs {
+			if err, ok := fsm.Apply(l).(error); ok {
+				t.Fatalf("applying entry %d: %s", l.Index, err)
+			}
+		}
+	}
+	apply(logs)
+	// Restart, Raft replays the entries after the last snapshot
+	apply(logs)
+
+	s, err := NewFileStore(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer s.Shutdown()
+	j, err := s.GetJob("job1", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if j.SuccessCount != 1 {
+		t.Fatalf("got success count %d after replay, want 1", j.SuccessCount)
+	}
+	index, err := s.GetAppliedIndex()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if index != 2 {
+		t.Fatalf("got applied index %d, want 2", index)
+	}
+}
@@ -0,0 +1,231 @@
+package core
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/tidwall/buntdb"
+)
+
+const (
+	// GraphFormatJSON returns the graph as nodes and edges, the default.
+	GraphFormatJSON = "json"
+	// GraphFormatDOT renders the graph in the Graphviz DOT language.
+	GraphFormatDOT = "dot"
+	// GraphFormatMermaid renders the graph as a Mermaid flowchart.
+	GraphFormatMermaid = "mermaid"
+)
+
+var (
+	// ErrWrongGraphFormat is returned when the graph format requested is not supported.
+	ErrWrongGraphFormat = errors.New("invalid graph format, use \"json\", \"dot\" or \"mermaid\"")
+)
+
+// graphColors are the fill colors of every job status in the renderings.
+var graphColors = map[string]string{
+	WorkflowSucceeded:       "palegreen",
+	WorkflowFailed:          "salmon",
+	WorkflowPartiallyFailed: "orange",
+	WorkflowRunning:         "lightblue",
+	WorkflowNotRun:          "white",
+}
+
+// Graph is the dependency graph of the jobs.
+type Graph struct {
+	// Job the graph is rooted at, empty for the whole cluster.
+	Root string `json:"root"`
+
+	// Jobs of the graph, sorted by name.
+	Nodes []*GraphNode `json:"nodes"`
+
+	// Dependencies between the jobs, from the parent to the child.
+	Edges []*GraphEdge `json:"edges"`
+}
+
+// GraphNode is a job of the dependency graph.
+type GraphNode struct {
+	// Name of the job.
+	Name string `json:"name"`
+
+	// Schedule of the job, empty for jobs running after their parents.
+	Schedule string `json:"schedule"`
+
+	// If the job is disabled.
+	Disabled bool `json:"disabled"`
+
+	// Status of the last run of the job, one of the Workflow* values.
+	Status string `json:"status"`
+}
+
+// GraphEdge is a dependency between two jobs of the graph.
+type GraphEdge struct {
+	// Name of the parent job.
+	From string `json:"from"`
+
+	// Name of the child job.
+	To string `json:"to"`
+
+	// Condition of the parent run that triggers the child, the edge
+	// condition of the child or its fan-in condition.
+	Condition string `json:"condition"`
+
+	// If the child waits for several parents.
+	FanIn bool `json:"fan_in"`
+}
+
+// lastStatus returns the status of the last run of the job, as a workflow node status.
+func (j *Job) lastStatus() string {
+	switch {
+	case j.Status == StatusRunning:
+		return WorkflowRunning
+	case !j.LastSuccess.HasValue() && !j.LastError.HasValue():
+		return WorkflowNotRun
+	case j.Status == StatusFailed:
+		return WorkflowFailed
+	case j.Status == StatusPartialyFailed:
+		return WorkflowPartiallyFailed
+	default:
+		return WorkflowSucceeded
+	}
+}
+
+// BuildGraph returns the dependency graph of the jobs, with only the root and
+// the jobs depending on it when root is set.
+func BuildGraph(jobs []*Job, root string) (*Graph, error) {
+	byName := make(map[string]*Job, len(jobs))
+	for _, j := range jobs {
+		byName[j.Name] = j
+	}
+
+	included := make(map[string]bool, len(jobs))
+	if root == "" {
+		for _, j := range jobs {
+			included[j.Name] = true
+		}
+	} else {
+		if _, ok := byName[root]; !ok {
+			return nil, buntdb.ErrNotFound
+		}
+		included[root] = true
+		queue := []string{root}
+		for len(queue) > 0 {
+			name := queue[0]
+			queue = queue[1:]
+			for _, child := range childJobs(jobs, name) {
+				if !included[child.Name] {
+					included[child.Name] = true
+					queue = append(queue, child.Name)
+				}
+			}
+		}
+	}
+
+	g := &Graph{
+		Root:  root,
+		Nodes: []*GraphNode{},
+		Edges: []*GraphEdge{},
+	}
+	for name := range included {
+		j := byName[name]
+		g.Nodes = append(g.Nodes, &GraphNode{
+			Name:     j.Name,
+			Schedule: j.Schedule,
+			Disabled: j.Disabled,
+			Status:   j.lastStatus(),
+		})
+
+		if j.ParentJob != "" && included[j.ParentJob] && name != root {
+			g.Edges = append(g.Edges, &GraphEdge{
+				From:      j.ParentJob,
+				To:        j.Name,
+				Condition: j.triggerEdge(),
+			})
+		}
+		for _, p := range j.ParentJobs {
+			if !included[p] || name == root {
+				continue
+			}
+			fanIn := j.FanIn
+			if fanIn == "" {
+				fanIn = FanInAllSucceeded
+			}
+			g.Edges = append(g.Edges, &GraphEdge{
+				From:      p,
+				To:        j.Name,
+				Condition: fanIn,
+				FanIn:     true,
+			})
+		}
+	}
+
+	sort.Slice(g.Nodes, func(i, j int) bool {
+		return g.Nodes[i].Name < g.Nodes[j].Name
+	})
+	sort.Slice(g.Edges, func(i, j int) bool {
+		if g.Edges[i].From != g.Edges[j].From {
+			return g.Edges[i].From < g.Edges[j].From
+		}
+		return g.Edges[i].To < g.Edges[j].To
+	})
+
+	return g, nil
+}
+
+// DOT renders the graph in the Graphviz DOT language, the nodes
+// are filled with the color of the last status of the job.
+func (g *Graph) DOT() string {
+	var b strings.Builder
+	b.WriteString("digraph jobs {\n")
+	b.WriteString("  rankdir=LR;\n")
+	b.WriteString("  node [shape=box, style=filled];\n")
+	for _, n := range g.Nodes {
+		style := ""
+		if n.Disabled {
+			style = ", style=\"filled,dashed\""
+		}
+		fmt.Fprintf(&b, "  %q [label=%q, fillcolor=%s%s];\n",
+			n.Name, n.Name+"\n"+n.Status, graphColors[n.Status], style)
+	}
+	for _, e := range g.Edges {
+		style := ""
+		if e.FanIn {
+			style = ", style=dashed"
+		}
+		fmt.Fprintf(&b, "  %q -> %q [label=%q%s];\n", e.From, e.To, e.Condition, style)
+	}
+	b.WriteString("}\n")
+	return b.String()
+}
+
+// Mermaid renders the graph as a Mermaid flowchart, the nodes
+// get the class of the last status of the job.
+func (g *Graph) Mermaid() string {
+	ids := make(map[string]string, len(g.Nodes))
+
+	var b strings.Builder
+	b.WriteString("flowchart LR\n")
+	for i, n := range g.Nodes {
+		id := fmt.Sprintf("n%d", i)
+		ids[n.Name] = id
+		fmt.Fprintf(&b, "  %s[\"%s<br/>%s\"]:::%s\n", id, n.Name, n.Status, n.Status)
+	}
+	for _, e := range g.Edges {
+		arrow := "-->"
+		if e.FanIn {
+			arrow = "-.->"
+		}
+		fmt.Fprintf(&b, "  %s %s|%s| %s\n", ids[e.From], arrow, e.Condition, ids[e.To])
+	}
+
+	statuses := make([]string, 0, len(graphColors))
+	for s := range graphColors {
+		statuses = append(statuses, s)
+	}
+	sort.Strings(statuses)
+	for _, s := range statuses {
+		fmt.Fprintf(&b, "  classDef %s fill:%s\n", s, graphColors[s])
+	}
+	return b.String()
+}
@@ -34,13 +34,13 @@ var (
 
 // DkronGRPCServer defines the basics that a gRPC server should implement.
 type DkronGRPCServer interface {
-	proto.DkronServer
+	proto.SpiderjobServer
 	Serve(net.Listener) error
 }
 
 // GRPCServer is the local implementation of the gRPC server interface.
 type GRPCServer struct {
-	proto.DkronServer
+	proto.SpiderjobServer
 	agent *Agent
 }
 
@@ -54,7 +54,7 @@ func NewGRPCServer(agent *Agent) DkronGRPCServer {
 // Serve creates and start a new gRPC dkron server
 func (grpcs *GRPCServer) Serve(lis net.Listener) error {
 	grpcServer := grpc.NewServer()
-	proto.RegisterDkronServer(grpcServer, grpcs)
+	proto.RegisterSpiderjobServer(grpcServer, grpcs)
 
 	as := NewAgentServer(grpcs.agent)
 	proto.RegisterAgentServer(grpcServer, as)
@@ -121,10 +121,62 @@ func (grpcs *GRPCServer) DeleteJob(ctx context.Context, delJobReq *proto.DeleteJ
 
 	// If everything is ok, remove the job
 	grpcs.agent.sched.RemoveJob(job)
+	go grpcs.agent.deleteExecutionLogs(job.Name, nil)
 
 	return &proto.DeleteJobResponse{Job: jpb}, nil
 }
 
+// SetCalendar broadcast a state change to the cluster members that will store the calendar,
+// then reschedules the jobs using it. This only works on the leader
+func (grpcs *GRPCServer) SetCalendar(ctx context.Context, req *proto.SetCalendarRequest) (*proto.SetCalendarResponse, error) {
+	defer metrics.MeasureSince([]string{"grpc", "set_calendar"}, time.Now())
+	log.WithField("calendar", req.Calendar.GetName()).Debug("grpc: Received SetCalendar")
+
+	cmd, err := Encode(SetCalendarType, req.Calendar)
+	if err != nil {
+		return nil, err
+	}
+	af := grpcs.agent.raft.Apply(cmd, raftTimeout)
+	if err := af.Error(); err != nil {
+		return nil, err
+	}
+	if err, ok := af.Response().(error); ok {
+		return nil, err
+	}
+
+	if err := grpcs.agent.rescheduleCalendarJobs(req.Calendar.GetName()); err != nil {
+		return nil, err
+	}
+
+	return &proto.SetCalendarResponse{Calendar: req.Calendar}, nil
+}
+
+// DeleteCalendar broadcast a state change to the cluster members that will delete the calendar.
+// This only works on the leader
+func (grpcs *GRPCServer) DeleteCalendar(ctx context.Context, req *proto.DeleteCalendarRequest) (*proto.DeleteCalendarResponse, error) {
+	defer metrics.MeasureSince([]string{"grpc", "delete_calendar"}, time.Now())
+	log.WithField("calendar", req.GetName()).Debug("grpc: Received DeleteCalendar")
+
+	cmd, err := Encode(DeleteCalendarType, req)
+	if err != nil {
+		return nil, err
+	}
+	af := grpcs.agent.raft.Apply(cmd, raftTimeout)
+	if err := af.Error(); err != nil {
+		return nil, err
+	}
+	res := af.Response()
+	if err, ok := res.(error); ok {
+		return nil, err
+	}
+	calendar, ok := res.(*Calendar)
+	if !ok {
+		return nil, fmt.Errorf("grpc: Error wrong response from apply in DeleteCalendar: %v", res)
+	}
+
+	return &proto.DeleteCalendarResponse{Calendar: calendar.ToProto()}, nil
+}
+
 // GetJob loads the job from the datastore
 func (grpcs *GRPCServer) GetJob(ctx context.Context, getJobReq *proto.GetJobRequest) (*proto.GetJobResponse, error) {
 	defer metrics.MeasureSince([]string{"grpc", "get_job"}, time.Now())
@@ -171,7 +223,7 @@ func (grpcs *GRPCServer) ExecutionDone(ctx context.Context, execDoneReq *proto.E
 		return nil, err
 	}
 
-	pbex := *execDoneReq.Execution
+	pbex := execDoneReq.Execution
 	for k, v := range job.Processors {
 		log.WithField("plugin", k).Info("grpc: Processing execution with plugin")
 		if processor, ok := grpcs.agent.ProcessorPlugins[k]; ok {
@@ -182,7 +234,7 @@ func (grpcs *GRPCServer) ExecutionDone(ctx context.Context, execDoneReq *proto.E
 		}
 	}
 
-	execDoneReq.Execution = &pbex
+	execDoneReq.Execution = pbex
 	cmd, err := Encode(ExecutionDoneType, execDoneReq)
 	if err != nil {
 		return nil, err
@@ -200,12 +252,39 @@ func (grpcs *GRPCServer) ExecutionDone(ctx context.Context, execDoneReq *proto.E
 	}
 
 	// If the execution failed, retry it until retries limit (default: don't retry)
-	execution := NewExecutionFromProto(&pbex)
-	if !execution.Success && uint(execution.Attempt) < job.Retries+1 {
+	execution := NewExecutionFromProto(pbex)
+	retry := !execution.Success && uint(execution.Attempt) < job.Retries+1 && job.RetryPolicy.ShouldRetry(execution)
+	delay := job.RetryPolicy.GetDelay(execution.Attempt)
+	// Immediate retries respect the allowed windows like any run,
+	// delayed ones are checked when they are due
+	if retry && delay <= 0 && !job.inAllowedWindow(time.Now()) {
+		grpcs.agent.recordSkipped(job, SkipOutsideWindow)
+		retry = false
+	}
+	if retry {
 		execution.Attempt++
 
-		// Keep all execution properties intact except the last output
+		// Keep all execution properties intact except the last results
 		execution.Output = ""
+		execution.ExitCode = 0
+		execution.Stdout = ""
+		execution.Stderr = ""
+		execution.FailureCategory = ""
+		execution.Results = nil
+
+		// Delayed retries are stored so a new leader runs them if this one fails
+		if delay > 0 {
+			if err := grpcs.agent.scheduleRetry(&PendingRetry{
+				Execution: execution,
+				RunAt:     time.Now().Add(delay),
+			}); err != nil {
+				return nil, err
+			}
+			return &proto.ExecutionDoneResponse{
+				From:    grpcs.agent.config.NodeName,
+				Payload: []byte("retry scheduled"),
+			}, nil
+		}
 
 		log.WithFields(logrus.Fields{
 			"attempt":   execution.Attempt,
@@ -231,22 +310,24 @@ func (grpcs *GRPCServer) ExecutionDone(ctx context.Context, execDoneReq *proto.E
 		return nil, err
 	}
 
+	if err := grpcs.agent.rearmAfterCompletion(job, exg); err != nil {
+		log.WithError(err).WithField("job", job.Name).Error("grpc: Error rearming job after completion")
+	}
+
+	// Jobs with parent jobs run once the runs of their parents meet their fan-in condition
+	if err := grpcs.agent.runFanInChildren(job, exg); err != nil {
+		log.WithError(err).WithField("job", job.Name).Error("grpc: Error running jobs with parent jobs")
+	}
+
 	// Send notification
 	if err := Notification(grpcs.agent.config, execution, exg, job).Send(); err != nil {
 		return nil, err
 	}
 
-	// Jobs that have dependent jobs are a bit more expensive because we need to call the Status() method for every execution.
-	// Check first if there's dependent jobs and then check for the job status to begin execution dependent jobs on success.
-	if len(job.DependentJobs) > 0 && job.Status == StatusSuccess {
-		for _, djn := range job.DependentJobs {
-			dj, err := grpcs.agent.Store.GetJob(djn, nil)
-			dj.Agent = grpcs.agent
-			if err != nil {
-				return nil, err
-			}
-			log.WithField("job", djn).Debug("grpc: Running dependent job")
-			dj.Run()
+	// Run the dependent jobs whose edge condition is met by the status of the finished run
+	if len(job.DependentJobs) > 0 {
+		if err := grpcs.agent.runDependentJobs(job, exg); err != nil {
+			return nil, err
 		}
 	}
 
@@ -263,6 +344,18 @@ func (grpcs *GRPCServer) Leave(ctx context.Context, in *empty.Empty) (*empty.Emp
 
 // RunJob runs a job in the cluster
 func (grpcs *GRPCServer) RunJob(ctx context.Context, req *proto.RunJobRequest) (*proto.RunJobResponse, error) {
+	// Manual runs respect the allowed windows unless forced
+	if !req.Force {
+		job, err := grpcs.agent.Store.GetJob(req.JobName, nil)
+		if err != nil {
+			return nil, err
+		}
+		if !job.inAllowedWindow(time.Now()) {
+			grpcs.agent.recordSkipped(job, SkipOutsideWindow)
+			return nil, ErrOutsideWindow
+		}
+	}
+
 	ex := NewExecution(req.JobName)
 	job, err := grpcs.agent.Run(req.JobName, ex)
 	if err != nil {
@@ -403,3 +496,31 @@ func (grpcs *GRPCServer) SetExecution(ctx context.Context, execution *proto.Exec
 
 	return new(empty.Empty), nil
 }
+
+// StreamExecution sends the updates of an execution streamed to this server
+// until it finishes, starting with the output it already sent.
+func (grpcs *GRPCServer) StreamExecution(req *proto.StreamExecutionRequest, stream proto.Spiderjob_StreamExecutionServer) error {
+	defer metrics.MeasureSince([]string{"grpc", "stream_execution"}, time.Now())
+
+	ch, unsubscribe, ok := grpcs.agent.executionBroker.subscribe(req.ExecutionId)
+	if !ok {
+		return ErrExecutionNotRunning
+	}
+	defer unsubscribe()
+
+	for {
+		select {
+		case execution, ok := <-ch:
+			if !ok {
+				return nil
+			}
+			if err := stream.Send(&proto.StreamExecutionResponse{
+				Execution: execution,
+			}); err != nil {
+				return err
+			}
+		case <-stream.Context().Done():
+			return stream.Context().Err()
+		}
+	}
+}
@@ -1,28 +1,74 @@
 package core
 
 import (
+	"context"
 	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"sync"
 	"time"
 
+	"spiderjob/lib/plugin"
 	"spiderjob/lib/plugin/types"
 
 	"github.com/armon/circbuf"
 	metrics "github.com/armon/go-metrics"
 	"github.com/golang/protobuf/ptypes"
+	"github.com/golang/protobuf/ptypes/empty"
 	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 const (
-	// maxBufSize limits how much data we collect from a handler.
-	maxBufSize = 256000
+	// maxBufSize limits how much output is kept in the replicated execution,
+	// the full output is kept in the log store of the node running it.
+	maxBufSize = 32 * 1024
 )
 
+var (
+	// ErrExecutionTimeout is returned when an execution exceeds the job timeout.
+	ErrExecutionTimeout = errors.New("grpc_agent: execution timed out")
+	// ErrExecutionCancelled is returned when an execution is stopped by a user.
+	ErrExecutionCancelled = errors.New("grpc_agent: execution cancelled")
+	// ErrExecutionNotRunning is returned when trying to stop an execution not running in this node.
+	ErrExecutionNotRunning = errors.New("grpc_agent: execution is not running in this node")
+)
+
+// executionStopper allows stopping a running execution
+// and records who requested it.
+type executionStopper struct {
+	cancel    context.CancelFunc
+	stoppedBy string
+}
+
 type statusAgentHelper struct {
 	execution *types.Execution
 	stream    types.Agent_AgentRunServer
+	log       io.Writer
+	logged    int64
+
+	// mu guards the execution, updated while the output is streamed
+	mu sync.Mutex
+	// finished is set once the execution is done, the executor can
+	// still send updates after a timeout but they are dropped.
+	finished bool
 }
 
 func (s *statusAgentHelper) Update(b []byte, c bool) (int64, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.finished {
+		return 0, nil
+	}
+
+	n, err := s.log.Write(b)
+	s.logged += int64(n)
+	if err != nil {
+		log.WithError(err).WithField("job", s.execution.JobName).Warn("grpc_agent: error writing execution log")
+	}
+
 	s.execution.Output = b
 	// Send partial execution
 	if err := s.stream.Send(&types.AgentRunStream{
@@ -33,6 +79,28 @@ func (s *statusAgentHelper) Update(b []byte, c bool) (int64, error) {
 	return 0, nil
 }
 
+// SetResult stores a named result value of the execution, sent with the next update.
+func (s *statusAgentHelper) SetResult(name, value string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.finished {
+		return nil
+	}
+	if s.execution.Results == nil {
+		s.execution.Results = make(map[string]string)
+	}
+	s.execution.Results[name] = value
+	return nil
+}
+
+// finish stops the updates of the execution, it can be read
+// and sent without the lock afterwards.
+func (s *statusAgentHelper) finish() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.finished = true
+}
+
 // GRPCAgentServer is the local implementation of the gRPC server interface.
 type AgentServer struct {
 	types.AgentServer
@@ -79,35 +147,101 @@ func (as *AgentServer) AgentRun(req *types.AgentRunRequest, stream types.Agent_A
 		return errors.New("grpc_agent: No executor defined, nothing to do")
 	}
 
+	// The full output is kept in the local log store, the execution only keeps the last part
+	var logw io.Writer = ioutil.Discard
+	if as.agent.logStore != nil {
+		w, err := as.agent.logStore.Writer(job.Name, execution.Key())
+		if err != nil {
+			log.WithError(err).WithField("job", job.Name).Warn("grpc_agent: error opening execution log, the full output won't be kept")
+		} else {
+			defer w.Close()
+			logw = w
+		}
+	}
+
 	// Check if executor exists
 	if executor, ok := as.agent.ExecutorPlugins[jex]; ok {
 		log.WithField("plugin", jex).Debug("grpc_agent: calling executor plugin")
 		runningExecutions.Store(execution.GetGroup(), execution)
-		out, err := executor.Execute(&types.ExecuteRequest{
-			JobName: job.Name,
-			Config:  exc,
-		}, &statusAgentHelper{
+
+		var ctx context.Context
+		var cancel context.CancelFunc
+		timeout := NewJobFromProto(job).GetTimeout()
+		if timeout > 0 {
+			ctx, cancel = context.WithTimeout(context.Background(), timeout)
+		} else {
+			ctx, cancel = context.WithCancel(context.Background())
+		}
+		stopper := &executionStopper{cancel: cancel}
+		as.agent.executionStoppers.Store(execution.Key(), stopper)
+
+		helper := &statusAgentHelper{
 			stream:    stream,
 			execution: execution,
-		})
+			log:       logw,
+		}
+		out, err := execute(ctx, executor, &types.ExecuteRequest{
+			JobName: job.Name,
+			Config:  exc,
+		}, helper)
+		// The executor may still be running after a timeout
+		helper.finish()
+		as.agent.executionStoppers.Delete(execution.Key())
+		cancel()
 
-		if err == nil && out.Error != "" {
+		if err == ErrExecutionTimeout {
+			log.WithField("job", job.Name).WithField("timeout", timeout).Warn("grpc_agent: execution timed out")
+			metrics.IncrCounterWithLabels([]string{"grpc_agent", "execution_timeout"}, 1, []metrics.Label{{Name: "job", Value: job.Name}})
+			execution.TimedOut = true
+			execution.FailureCategory = FailureTimeout
+		} else if err == ErrExecutionCancelled {
+			log.WithField("job", job.Name).WithField("user", stopper.stoppedBy).Warn("grpc_agent: execution cancelled")
+			metrics.IncrCounterWithLabels([]string{"grpc_agent", "execution_cancelled"}, 1, []metrics.Label{{Name: "job", Value: job.Name}})
+			execution.Cancelled = true
+			execution.CancelledBy = stopper.stoppedBy
+			execution.FailureCategory = FailureCancelled
+			err = fmt.Errorf("%s by %s", err, stopper.stoppedBy)
+		} else if err != nil {
+			execution.FailureCategory = FailureExecutorError
+		} else if out.Error != "" {
 			err = errors.New(out.Error)
+			if out.ExitCode != 0 {
+				execution.FailureCategory = FailureNonZeroExit
+			} else {
+				execution.FailureCategory = FailureExecutorError
+			}
 		}
 		if err != nil {
 			log.WithError(err).WithField("job", job.Name).WithField("plugin", executor).Error("grpc_agent: command error output")
 			success = false
 			output.Write([]byte(err.Error() + "\n"))
+			logw.Write([]byte(err.Error() + "\n"))
 		} else {
 			success = true
 		}
 
 		if out != nil {
 			output.Write(out.Output)
+			// Executors not streaming their output only return it at the end
+			if helper.logged == 0 {
+				logw.Write(out.Output)
+			}
+			execution.ExitCode = out.ExitCode
+			execution.Stdout = tail(out.Stdout, maxBufSize)
+			execution.Stderr = tail(out.Stderr, maxBufSize)
+			// Results returned at the end override the ones set while running
+			if execution.Results == nil {
+				execution.Results = make(map[string]string, len(out.Results))
+			}
+			for name, value := range out.Results {
+				execution.Results[name] = value
+			}
 		}
 	} else {
 		log.WithField("executor", jex).Error("grpc_agent: Specified executor is not present")
 		output.Write([]byte("grpc_agent: Specified executor is not present"))
+		logw.Write([]byte("grpc_agent: Specified executor is not present"))
+		execution.FailureCategory = FailureExecutorMissing
 	}
 
 	execution.FinishedAt = ptypes.TimestampNow()
@@ -131,3 +265,103 @@ func (as *AgentServer) AgentRun(req *types.AgentRunRequest, stream types.Agent_A
 
 	return nil
 }
+
+// StopExecution cancels the context of an execution running in this node,
+// the execution finishes as failed and records who stopped it.
+func (as *AgentServer) StopExecution(ctx context.Context, req *types.StopExecutionRequest) (*types.StopExecutionResponse, error) {
+	defer metrics.MeasureSince([]string{"grpc_agent", "stop_execution"}, time.Now())
+	log.WithFields(logrus.Fields{
+		"job":       req.JobName,
+		"execution": req.ExecutionId,
+		"user":      req.StoppedBy,
+	}).Info("grpc_agent: Stopping execution")
+
+	v, ok := as.agent.executionStoppers.Load(req.ExecutionId)
+	if !ok {
+		return nil, ErrExecutionNotRunning
+	}
+	stopper := v.(*executionStopper)
+	stopper.stoppedBy = req.StoppedBy
+	stopper.cancel()
+
+	return &types.StopExecutionResponse{
+		From: as.agent.config.NodeName,
+	}, nil
+}
+
+// GetExecutionLog returns a range of the full output of an execution run in this node.
+func (as *AgentServer) GetExecutionLog(ctx context.Context, req *types.GetExecutionLogRequest) (*types.GetExecutionLogResponse, error) {
+	defer metrics.MeasureSince([]string{"grpc_agent", "get_execution_log"}, time.Now())
+
+	if as.agent.logStore == nil {
+		return nil, status.Error(codes.NotFound, ErrExecutionLogNotFound.Error())
+	}
+	l, err := as.agent.logStore.Read(req.JobName, req.ExecutionId, req.Offset, req.Limit)
+	if err == ErrExecutionLogNotFound {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.GetExecutionLogResponse{
+		Data:       []byte(l.Data),
+		Offset:     l.Offset,
+		NextOffset: l.NextOffset,
+		Size:       l.Size,
+	}, nil
+}
+
+// DeleteExecutionLogs removes the logs of executions run in this node,
+// every log of the job when no execution is given.
+func (as *AgentServer) DeleteExecutionLogs(ctx context.Context, req *types.DeleteExecutionLogsRequest) (*empty.Empty, error) {
+	defer metrics.MeasureSince([]string{"grpc_agent", "delete_execution_logs"}, time.Now())
+
+	if as.agent.logStore == nil {
+		return &empty.Empty{}, nil
+	}
+	if len(req.ExecutionIds) == 0 {
+		return &empty.Empty{}, as.agent.logStore.DeleteJob(req.JobName)
+	}
+	for _, id := range req.ExecutionIds {
+		if err := as.agent.logStore.Delete(req.JobName, id); err != nil {
+			return nil, err
+		}
+	}
+	return &empty.Empty{}, nil
+}
+
+// execute calls the executor and stops waiting for it once ctx is done,
+// so executors not honouring the context can't block the agent forever.
+func execute(ctx context.Context, executor plugin.Executor, req *types.ExecuteRequest, cb plugin.StatusHelper) (*types.ExecuteResponse, error) {
+	type result struct {
+		out *types.ExecuteResponse
+		err error
+	}
+	done := make(chan result, 1)
+	go func() {
+		out, err := executor.Execute(ctx, req, cb)
+		done <- result{out, err}
+	}()
+
+	select {
+	case r := <-done:
+		if ctx.Err() == nil {
+			return r.out, r.err
+		}
+	case <-ctx.Done():
+	}
+
+	if ctx.Err() == context.DeadlineExceeded {
+		return nil, ErrExecutionTimeout
+	}
+	return nil, ErrExecutionCancelled
+}
+
+// tail returns the last n bytes of b.
+func tail(b []byte, n int) []byte {
+	if len(b) > n {
+		return b[len(b)-n:]
+	}
+	return b
+}
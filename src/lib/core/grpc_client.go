@@ -23,13 +23,19 @@ type DkronGRPCClient interface {
 	GetJob(string, string) (*Job, error)
 	SetJob(*Job) error
 	DeleteJob(string) (*Job, error)
+	SetCalendar(*Calendar) error
+	DeleteCalendar(string) (*Calendar, error)
 	Leave(string) error
-	RunJob(string) (*Job, error)
+	RunJob(string, bool) (*Job, error)
 	RaftGetConfiguration(string) (*proto.RaftGetConfigurationResponse, error)
 	RaftRemovePeerByID(string, string) error
 	GetActiveExecutions(string) ([]*proto.Execution, error)
 	SetExecution(execution *proto.Execution) error
 	AgentRun(addr string, job *proto.Job, execution *proto.Execution) error
+	StopExecution(addr, jobName, executionID, stoppedBy string) error
+	GetExecutionLog(addr, jobName, executionID string, offset, limit int64) (*ExecutionLog, error)
+	DeleteExecutionLogs(addr, jobName string, executionIDs []string) error
+	StreamExecution(ctx context.Context, addr, jobName, executionID string, fn func(*proto.Execution) error) error
 }
 
 // GRPCClient is the local implementation of the DkronGRPCClient interface.
@@ -78,7 +84,7 @@ func (grpcc *GRPCClient) ExecutionDone(addr string, execution *Execution) error
 		return err
 	}
 
-	d := proto.NewDkronClient(conn)
+	d := proto.NewSpiderjobClient(conn)
 	edr, err := d.ExecutionDone(context.Background(), &proto.ExecutionDoneRequest{Execution: execution.ToProto()})
 	if err != nil {
 		if err.Error() == fmt.Sprintf("rpc error: code = Unknown desc = %s", ErrNotLeader.Error()) {
@@ -120,7 +126,7 @@ func (grpcc *GRPCClient) GetJob(addr, jobName string) (*Job, error) {
 	defer conn.Close()
 
 	// Synchronous call
-	d := proto.NewDkronClient(conn)
+	d := proto.NewSpiderjobClient(conn)
 	gjr, err := d.GetJob(context.Background(), &proto.GetJobRequest{JobName: jobName})
 	if err != nil {
 		log.WithError(err).WithFields(logrus.Fields{
@@ -149,7 +155,7 @@ func (grpcc *GRPCClient) Leave(addr string) error {
 	defer conn.Close()
 
 	// Synchronous call
-	d := proto.NewDkronClient(conn)
+	d := proto.NewSpiderjobClient(conn)
 	_, err = d.Leave(context.Background(), &empty.Empty{})
 	if err != nil {
 		log.WithError(err).WithFields(logrus.Fields{
@@ -180,7 +186,7 @@ func (grpcc *GRPCClient) SetJob(job *Job) error {
 	defer conn.Close()
 
 	// Synchronous call
-	d := proto.NewDkronClient(conn)
+	d := proto.NewSpiderjobClient(conn)
 	_, err = d.SetJob(context.Background(), &proto.SetJobRequest{
 		Job: job.ToProto(),
 	})
@@ -212,7 +218,7 @@ func (grpcc *GRPCClient) DeleteJob(jobName string) (*Job, error) {
 	defer conn.Close()
 
 	// Synchronous call
-	d := proto.NewDkronClient(conn)
+	d := proto.NewSpiderjobClient(conn)
 	res, err := d.DeleteJob(context.Background(), &proto.DeleteJobRequest{
 		JobName: jobName,
 	})
@@ -229,8 +235,74 @@ func (grpcc *GRPCClient) DeleteJob(jobName string) (*Job, error) {
 	return job, nil
 }
 
-// RunJob calls the leader passing the job name
-func (grpcc *GRPCClient) RunJob(jobName string) (*Job, error) {
+// SetCalendar calls the leader passing the calendar
+func (grpcc *GRPCClient) SetCalendar(calendar *Calendar) error {
+	var conn *grpc.ClientConn
+
+	addr := grpcc.agent.raft.Leader()
+
+	// Initiate a connection with the server
+	conn, err := grpcc.Connect(string(addr))
+	if err != nil {
+		log.WithError(err).WithFields(logrus.Fields{
+			"method":      "SetCalendar",
+			"server_addr": addr,
+		}).Error("grpc: error dialing.")
+		return err
+	}
+	defer conn.Close()
+
+	// Synchronous call
+	d := proto.NewSpiderjobClient(conn)
+	_, err = d.SetCalendar(context.Background(), &proto.SetCalendarRequest{
+		Calendar: calendar.ToProto(),
+	})
+	if err != nil {
+		log.WithError(err).WithFields(logrus.Fields{
+			"method":      "SetCalendar",
+			"server_addr": addr,
+		}).Error("grpc: Error calling gRPC method")
+		return err
+	}
+	return nil
+}
+
+// DeleteCalendar calls the leader passing the calendar name
+func (grpcc *GRPCClient) DeleteCalendar(name string) (*Calendar, error) {
+	var conn *grpc.ClientConn
+
+	addr := grpcc.agent.raft.Leader()
+
+	// Initiate a connection with the server
+	conn, err := grpcc.Connect(string(addr))
+	if err != nil {
+		log.WithError(err).WithFields(logrus.Fields{
+			"method":      "DeleteCalendar",
+			"server_addr": addr,
+		}).Error("grpc: error dialing.")
+		return nil, err
+	}
+	defer conn.Close()
+
+	// Synchronous call
+	d := proto.NewSpiderjobClient(conn)
+	res, err := d.DeleteCalendar(context.Background(), &proto.DeleteCalendarRequest{
+		Name: name,
+	})
+	if err != nil {
+		log.WithError(err).WithFields(logrus.Fields{
+			"method":      "DeleteCalendar",
+			"server_addr": addr,
+		}).Error("grpc: Error calling gRPC method")
+		return nil, err
+	}
+
+	return NewCalendarFromProto(res.Calendar), nil
+}
+
+// RunJob calls the leader passing the job name, forced runs
+// ignore the allowed windows of the job
+func (grpcc *GRPCClient) RunJob(jobName string, force bool) (*Job, error) {
 	var conn *grpc.ClientConn
 
 	addr := grpcc.agent.raft.Leader()
@@ -247,9 +319,10 @@ func (grpcc *GRPCClient) RunJob(jobName string) (*Job, error) {
 	defer conn.Close()
 
 	// Synchronous call
-	d := proto.NewDkronClient(conn)
+	d := proto.NewSpiderjobClient(conn)
 	res, err := d.RunJob(context.Background(), &proto.RunJobRequest{
 		JobName: jobName,
+		Force:   force,
 	})
 	if err != nil {
 		log.WithError(err).WithFields(logrus.Fields{
@@ -280,7 +353,7 @@ func (grpcc *GRPCClient) RaftGetConfiguration(addr string) (*proto.RaftGetConfig
 	defer conn.Close()
 
 	// Synchronous call
-	d := proto.NewDkronClient(conn)
+	d := proto.NewSpiderjobClient(conn)
 	res, err := d.RaftGetConfiguration(context.Background(), &empty.Empty{})
 	if err != nil {
 		log.WithError(err).WithFields(logrus.Fields{
@@ -309,7 +382,7 @@ func (grpcc *GRPCClient) RaftRemovePeerByID(addr, peerID string) error {
 	defer conn.Close()
 
 	// Synchronous call
-	d := proto.NewDkronClient(conn)
+	d := proto.NewSpiderjobClient(conn)
 	_, err = d.RaftRemovePeerByID(context.Background(),
 		&proto.RaftRemovePeerByIDRequest{Id: peerID},
 	)
@@ -340,7 +413,7 @@ func (grpcc *GRPCClient) GetActiveExecutions(addr string) ([]*proto.Execution, e
 	defer conn.Close()
 
 	// Synchronous call
-	d := proto.NewDkronClient(conn)
+	d := proto.NewSpiderjobClient(conn)
 	gaer, err := d.GetActiveExecutions(context.Background(), &empty.Empty{})
 	if err != nil {
 		log.WithError(err).WithFields(logrus.Fields{
@@ -371,7 +444,7 @@ func (grpcc *GRPCClient) SetExecution(execution *proto.Execution) error {
 	defer conn.Close()
 
 	// Synchronous call
-	d := proto.NewDkronClient(conn)
+	d := proto.NewSpiderjobClient(conn)
 	_, err = d.SetExecution(context.Background(), execution)
 	if err != nil {
 		log.WithError(err).WithFields(logrus.Fields{
@@ -427,8 +500,11 @@ func (grpcc *GRPCClient) AgentRun(addr string, job *proto.Job, execution *proto.
 			// At this point the execution status will be unknown, set the FinshedAt time and an explanatory message
 			execution.FinishedAt = ptypes.TimestampNow()
 			execution.Output = []byte(err.Error())
+			execution.Success = false
+			execution.FailureCategory = FailureNodeLost
 
 			log.WithError(err).Error(ErrBrokenStream)
+			grpcc.agent.executionBroker.publish(execution.Key(), execution)
 
 			addr := grpcc.agent.raft.Leader()
 			if err := grpcc.ExecutionDone(string(addr), NewExecutionFromProto(execution)); err != nil {
@@ -446,10 +522,163 @@ func (grpcc *GRPCClient) AgentRun(addr string, job *proto.Job, execution *proto.
 
 		// Store the received execution in the raft log and store
 		if !first {
+			grpcc.agent.executionBroker.open(execution.Key())
+			defer grpcc.agent.executionBroker.close(execution.Key())
+
 			if err := grpcc.SetExecution(ars.Execution); err != nil {
 				return err
 			}
 			first = true
 		}
+
+		// Forward the update to the clients following the execution
+		grpcc.agent.executionBroker.publish(execution.Key(), execution)
+	}
+}
+
+// StopExecution calls the agent running the execution to stop it
+func (grpcc *GRPCClient) StopExecution(addr, jobName, executionID, stoppedBy string) error {
+	defer metrics.MeasureSince([]string{"grpc_client", "stop_execution"}, time.Now())
+	var conn *grpc.ClientConn
+
+	// Initiate a connection with the server
+	conn, err := grpcc.Connect(addr)
+	if err != nil {
+		log.WithError(err).WithFields(logrus.Fields{
+			"method":      "StopExecution",
+			"server_addr": addr,
+		}).Error("grpc: error dialing.")
+		return err
+	}
+	defer conn.Close()
+
+	// Synchronous call
+	a := proto.NewAgentClient(conn)
+	_, err = a.StopExecution(context.Background(), &proto.StopExecutionRequest{
+		JobName:     jobName,
+		ExecutionId: executionID,
+		StoppedBy:   stoppedBy,
+	})
+	if err != nil {
+		log.WithError(err).WithFields(logrus.Fields{
+			"method":      "StopExecution",
+			"server_addr": addr,
+		}).Error("grpc: Error calling gRPC method")
+		return err
+	}
+	return nil
+}
+
+// GetExecutionLog calls the agent that run the execution to read its log
+func (grpcc *GRPCClient) GetExecutionLog(addr, jobName, executionID string, offset, limit int64) (*ExecutionLog, error) {
+	defer metrics.MeasureSince([]string{"grpc_client", "get_execution_log"}, time.Now())
+	var conn *grpc.ClientConn
+
+	// Initiate a connection with the server
+	conn, err := grpcc.Connect(addr)
+	if err != nil {
+		log.WithError(err).WithFields(logrus.Fields{
+			"method":      "GetExecutionLog",
+			"server_addr": addr,
+		}).Error("grpc: error dialing.")
+		return nil, err
+	}
+	defer conn.Close()
+
+	// Synchronous call
+	a := proto.NewAgentClient(conn)
+	resp, err := a.GetExecutionLog(context.Background(), &proto.GetExecutionLogRequest{
+		JobName:     jobName,
+		ExecutionId: executionID,
+		Offset:      offset,
+		Limit:       limit,
+	})
+	if err != nil {
+		log.WithError(err).WithFields(logrus.Fields{
+			"method":      "GetExecutionLog",
+			"server_addr": addr,
+		}).Error("grpc: Error calling gRPC method")
+		return nil, err
+	}
+
+	return &ExecutionLog{
+		Offset:     resp.Offset,
+		NextOffset: resp.NextOffset,
+		Size:       resp.Size,
+		Data:       string(resp.Data),
+	}, nil
+}
+
+// DeleteExecutionLogs calls the agent that run the executions to delete their logs
+func (grpcc *GRPCClient) DeleteExecutionLogs(addr, jobName string, executionIDs []string) error {
+	defer metrics.MeasureSince([]string{"grpc_client", "delete_execution_logs"}, time.Now())
+	var conn *grpc.ClientConn
+
+	// Initiate a connection with the server
+	conn, err := grpcc.Connect(addr)
+	if err != nil {
+		log.WithError(err).WithFields(logrus.Fields{
+			"method":      "DeleteExecutionLogs",
+			"server_addr": addr,
+		}).Error("grpc: error dialing.")
+		return err
+	}
+	defer conn.Close()
+
+	// Synchronous call
+	a := proto.NewAgentClient(conn)
+	_, err = a.DeleteExecutionLogs(context.Background(), &proto.DeleteExecutionLogsRequest{
+		JobName:      jobName,
+		ExecutionIds: executionIDs,
+	})
+	if err != nil {
+		log.WithError(err).WithFields(logrus.Fields{
+			"method":      "DeleteExecutionLogs",
+			"server_addr": addr,
+		}).Error("grpc: Error calling gRPC method")
+		return err
+	}
+
+	return nil
+}
+
+// StreamExecution follows the updates of an execution streamed to the given server,
+// fn is called for every update until the execution finishes or ctx is done.
+func (grpcc *GRPCClient) StreamExecution(ctx context.Context, addr, jobName, executionID string, fn func(*proto.Execution) error) error {
+	defer metrics.MeasureSince([]string{"grpc_client", "stream_execution"}, time.Now())
+	var conn *grpc.ClientConn
+
+	// Initiate a connection with the server
+	conn, err := grpcc.Connect(addr)
+	if err != nil {
+		log.WithError(err).WithFields(logrus.Fields{
+			"method":      "StreamExecution",
+			"server_addr": addr,
+		}).Error("grpc: error dialing.")
+		return err
+	}
+	defer conn.Close()
+
+	// Streaming call
+	d := proto.NewSpiderjobClient(conn)
+	stream, err := d.StreamExecution(ctx, &proto.StreamExecutionRequest{
+		JobName:     jobName,
+		ExecutionId: executionID,
+	})
+	if err != nil {
+		return err
+	}
+
+	for {
+		resp, err := stream.Recv()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if err := fn(resp.Execution); err != nil {
+			return err
+		}
 	}
 }
@@ -24,6 +24,14 @@ const (
 	StatusPartialyFailed = "partially_failed"
 	ConcurrencyAllow     = "allow"
 	ConcurrencyForbid    = "forbid"
+
+	// RetryPlacementSameNode retries on the node that failed, the default.
+	RetryPlacementSameNode = "same-node"
+	// RetryPlacementAnyNode retries on any node matching the job tags.
+	RetryPlacementAnyNode = "any-node"
+	// RetryPlacementDifferentNode retries on a node matching the job tags
+	// that didn't fail the execution group yet.
+	RetryPlacementDifferentNode = "different-node"
 )
 
 var (
@@ -33,59 +41,83 @@ var (
 	ErrNoParent          = errors.New("the job doesn't have a parent job set")
 	ErrNoCommand         = errors.New("unspecified command for job")
 	ErrWrongConcurrency  = errors.New("invalid concurrency policy value, use \"allow\" or \"forbid\"")
+	ErrWrongTimeout      = errors.New("invalid timeout value, use a positive duration like \"30s\" or \"1h\"")
+	ErrWrongPlacement    = errors.New("invalid retry placement value, use \"same-node\", \"any-node\" or \"different-node\"")
 )
 
 type Job struct {
-	ID             string                      `json:"id"`
-	Name           string                      `json:"name"`
-	DisplayName    string                      `json:"display"`
-	Timezone       string                      `json:"timezone"`
-	Schedule       string                      `json:"schedule"`
-	Owner          string                      `json:"owner"`
-	OwnerEmail     string                      `json:"owner_email"`
-	SuccessCount   int                         `json:"success_count"`
-	ErrorCount     int                         `json:"error_count"`
-	LastSuccess    ntime.NullableTime          `json:"last_success"`
-	LastError      ntime.NullableTime          `json:"last_error"`
-	Disabled       bool                        `json:"disabled"`
-	Tags           map[string]string           `json:"tags"`
-	Metadata       map[string]string           `json:"metadata"`
-	Agent          *Agent                      `json:"-"`
-	Retries        uint                        `json:"retries"`
-	DependentJobs  []string                    `json:"dependent_jobs"`
-	ChildJobs      []*Job                      `json:"-"`
-	ParentJob      string                      `json:"parent_job`
-	Processors     map[string]plugin.Config    `json:"processors"`
-	Concurrency    string                      `json:"concurrency"`
-	Executor       string                      `json:"executor"`
-	ExecutorConfig plugin.ExecutorPluginConfig `json:"executor_config"`
-	Status         string                      `json:"status"`
-	Next           time.Time                   `json:"next"`
+	ID              string                      `json:"id"`
+	Name            string                      `json:"name"`
+	DisplayName     string                      `json:"display"`
+	Timezone        string                      `json:"timezone"`
+	Schedule        string                      `json:"schedule"`
+	Owner           string                      `json:"owner"`
+	OwnerEmail      string                      `json:"owner_email"`
+	SuccessCount    int                         `json:"success_count"`
+	ErrorCount      int                         `json:"error_count"`
+	LastSuccess     ntime.NullableTime          `json:"last_success"`
+	LastError       ntime.NullableTime          `json:"last_error"`
+	Disabled        bool                        `json:"disabled"`
+	Tags            map[string]string           `json:"tags"`
+	Metadata        map[string]string           `json:"metadata"`
+	Agent           *Agent                      `json:"-"`
+	Retries         uint                        `json:"retries"`
+	DependentJobs   []string                    `json:"dependent_jobs"`
+	ChildJobs       []*Job                      `json:"-"`
+	ParentJob       string                      `json:"parent_job"`
+	Processors      map[string]plugin.Config    `json:"processors"`
+	Concurrency     string                      `json:"concurrency"`
+	Executor        string                      `json:"executor"`
+	ExecutorConfig  plugin.ExecutorPluginConfig `json:"executor_config"`
+	Status          string                      `json:"status"`
+	Next            time.Time                   `json:"next"`
+	Timeout         string                      `json:"timeout"`
+	Retention       *RetentionPolicy            `json:"retention,omitempty"`
+	RetryPolicy     *RetryPolicy                `json:"retry_policy,omitempty"`
+	RetryPlacement  string                      `json:"retry_placement"`
+	MissedRunPolicy *MissedRunPolicy            `json:"missed_run_policy,omitempty"`
+	Calendars       []string                    `json:"calendars"`
+	AllowedWindows  []*TimeWindow               `json:"allowed_windows"`
+	ParentJobs      []string                    `json:"parent_jobs"`
+	FanIn           string                      `json:"fan_in"`
+	TriggerOn       string                      `json:"trigger_on"`
 }
 
 func NewJobFromProto(in *proto.Job) *Job {
 	next, _ := ptypes.Timestamp(in.GetNext())
 	job := &Job{
-		ID:             in.Name,
-		Name:           in.Name,
-		DisplayName:    in.Displayname,
-		Timezone:       in.Timezone,
-		Schedule:       in.Schedule,
-		Owner:          in.Owner,
-		OwnerEmail:     in.OwnerEmail,
-		SuccessCount:   int(in.SuccessCount),
-		ErrorCount:     int(in.ErrorCount),
-		Disabled:       in.Disabled,
-		Tags:           in.Tags,
-		Retries:        uint(in.Retries),
-		DependentJobs:  in.DependentJobs,
-		ParentJob:      in.ParentJob,
-		Concurrency:    in.Concurrency,
-		Executor:       in.Executor,
-		ExecutorConfig: in.ExecutorConfig,
-		Status:         in.Status,
-		Metadata:       in.Metadata,
-		Next:           next,
+		ID:              in.Name,
+		Name:            in.Name,
+		DisplayName:     in.Displayname,
+		Timezone:        in.Timezone,
+		Schedule:        in.Schedule,
+		Owner:           in.Owner,
+		OwnerEmail:      in.OwnerEmail,
+		SuccessCount:    int(in.SuccessCount),
+		ErrorCount:      int(in.ErrorCount),
+		Disabled:        in.Disabled,
+		Tags:            in.Tags,
+		Retries:         uint(in.Retries),
+		DependentJobs:   in.DependentJobs,
+		ParentJob:       in.ParentJob,
+		Concurrency:     in.Concurrency,
+		Executor:        in.Executor,
+		ExecutorConfig:  in.ExecutorConfig,
+		Status:          in.Status,
+		Metadata:        in.Metadata,
+		Next:            next,
+		Timeout:         in.Timeout,
+		Retention:       NewRetentionPolicyFromProto(in.Retention),
+		RetryPolicy:     NewRetryPolicyFromProto(in.RetryPolicy),
+		RetryPlacement:  in.RetryPlacement,
+		MissedRunPolicy: NewMissedRunPolicyFromProto(in.MissedRunPolicy),
+		Calendars:       in.Calendars,
+		ParentJobs:      in.ParentJobs,
+		FanIn:           in.FanIn,
+		TriggerOn:       in.TriggerOn,
+	}
+	for _, w := range in.AllowedWindows {
+		job.AllowedWindows = append(job.AllowedWindows, NewTimeWindowFromProto(w))
 	}
 	if in.GetLastSuccess().GetHasValue() {
 		t, _ := ptypes.Timestamp(in.GetLastSuccess().GetTime())
@@ -122,33 +154,48 @@ func (j *Job) ToProto() *proto.Job {
 	}
 	next, _ := ptypes.TimestampProto(j.Next)
 
+	var windows []*proto.TimeWindow
+	for _, w := range j.AllowedWindows {
+		windows = append(windows, w.ToProto())
+	}
+
 	processors := make(map[string]*proto.PluginConfig)
 	for k, v := range j.Processors {
-		process[k] = &proto.PluginConfig{Config: v}
+		processors[k] = &proto.PluginConfig{Config: v}
 	}
 	return &proto.Job{
-		Name:           j.Name,
-		Displayname:    j.DisplayName,
-		Timezone:       j.Timezone,
-		Schedule:       j.Schedule,
-		Owner:          j.Owner,
-		OwnerEmail:     j.OwnerEmail,
-		SuccessCount:   int32(j.SuccessCount),
-		ErrorCount:     int32(j.ErrorCount),
-		Disabled:       j.Disabled,
-		Tags:           j.Tags,
-		Retries:        uint32(j.Retries),
-		DependentJobs:  j.DependentJobs,
-		ParentJob:      j.ParentJob,
-		Concurrency:    j.Concurrency,
-		Processors:     processors,
-		Executor:       j.Executor,
-		ExecutorConfig: j.ExecutorConfig,
-		Status:         j.Status,
-		Metadata:       j.Metadata,
-		LastSuccess:    lastSuccess,
-		LastError:      lastError,
-		Next:           next,
+		Name:            j.Name,
+		Displayname:     j.DisplayName,
+		Timezone:        j.Timezone,
+		Schedule:        j.Schedule,
+		Owner:           j.Owner,
+		OwnerEmail:      j.OwnerEmail,
+		SuccessCount:    int32(j.SuccessCount),
+		ErrorCount:      int32(j.ErrorCount),
+		Disabled:        j.Disabled,
+		Tags:            j.Tags,
+		Retries:         uint32(j.Retries),
+		DependentJobs:   j.DependentJobs,
+		ParentJob:       j.ParentJob,
+		Concurrency:     j.Concurrency,
+		Processors:      processors,
+		Executor:        j.Executor,
+		ExecutorConfig:  j.ExecutorConfig,
+		Status:          j.Status,
+		Metadata:        j.Metadata,
+		LastSuccess:     lastSuccess,
+		LastError:       lastError,
+		Next:            next,
+		Timeout:         j.Timeout,
+		Retention:       j.Retention.ToProto(),
+		RetryPolicy:     j.RetryPolicy.ToProto(),
+		RetryPlacement:  j.RetryPlacement,
+		MissedRunPolicy: j.MissedRunPolicy.ToProto(),
+		Calendars:       j.Calendars,
+		AllowedWindows:  windows,
+		ParentJobs:      j.ParentJobs,
+		FanIn:           j.FanIn,
+		TriggerOn:       j.TriggerOn,
 	}
 }
 
@@ -157,17 +204,35 @@ func (j *Job) Run() {
 		log.Fatal("job: agent not set")
 	}
 
-	if j.isRunnable() {
-		log.WithFields(logrus.Fields{
-			"job":      j.Name,
-			"schedule": j.Schedule,
-		}).Debug("job: Run job")
-		cronInspect.Set(j.Name, j)
-		ex := NewExecution(j.Name)
-
-		if _, err := j.Agent.Run(j.Name, ex); err != nil {
-			log.WithError(err).Error("job: Error running job")
+	runnable, reason := j.isRunnable()
+	if !runnable {
+		if reason != "" {
+			j.Agent.recordSkipped(j, reason)
 		}
+		// Jobs running after completion are only rearmed when a run finishes
+		j.Agent.rearmMissedRun(j.Name)
+		return
+	}
+
+	log.WithFields(logrus.Fields{
+		"job":      j.Name,
+		"schedule": j.Schedule,
+	}).Debug("job: Run job")
+	cronInspect.Set(j.Name, j)
+	if _, err := j.Agent.Run(j.Name, NewExecution(j.Name)); err != nil {
+		log.WithError(err).Error("job: Error running job")
+		j.Agent.rearmMissedRun(j.Name)
+	}
+}
+
+// RunExecution runs the job with the given execution, without checking if it's runnable.
+func (j *Job) RunExecution(ex *Execution) {
+	if j.Agent == nil {
+		log.Fatal("job: agent not set")
+	}
+
+	if _, err := j.Agent.Run(j.Name, ex); err != nil {
+		log.WithError(err).Error("job: Error running job")
 	}
 }
 
@@ -202,7 +267,7 @@ func (j *Job) GetTimeLocation() *time.Location {
 
 func (j *Job) GetNext() (time.Time, error) {
 	if j.Schedule != "" {
-		s, err := extcron.Parse(j.Schedule)
+		s, err := extcron.ParseHashed(j.Schedule, j.Name)
 		if err != nil {
 			return time.Time{}, err
 		}
@@ -212,21 +277,37 @@ func (j *Job) GetNext() (time.Time, error) {
 	return time.Time{}, nil
 }
 
-func (j *Job) isRunnable() bool {
+// GetTimeout returns the maximum time an execution of the job is allowed
+// to run, zero means no limit.
+func (j *Job) GetTimeout() time.Duration {
+	if j.Timeout == "" {
+		return 0
+	}
+	d, _ := time.ParseDuration(j.Timeout)
+	return d
+}
+
+// isRunnable returns if the job can run now. When it can't, the reason is
+// the skip reason to record for the run, empty if the run isn't recorded.
+func (j *Job) isRunnable() (bool, string) {
 	if j.Disabled {
-		return false
+		return false, ""
 	}
 
 	if j.Agent.GlobalLock {
 		log.WithField("job", j.Name).Warning("job: Skipping execution because active global lock")
-		return false
+		return false, ""
+	}
+
+	if !j.inAllowedWindow(time.Now()) {
+		return false, SkipOutsideWindow
 	}
 
 	if j.Concurrency == ConcurrencyForbid {
 		exs, err := j.Agent.GetActiveExecutions()
 		if err != nil {
 			log.WithError(err).Error("job: Error quering for running executions")
-			return false
+			return false, ""
 		}
 
 		for _, e := range exs {
@@ -236,12 +317,12 @@ func (j *Job) isRunnable() bool {
 					"concurrency": j.Concurrency,
 					"job_status":  j.Status,
 				}).Info("job: Skipping concurrent execution")
-				return false
+				return false, ""
 			}
 		}
 	}
 
-	return true
+	return true, ""
 }
 
 func (j *Job) Validate() error {
@@ -253,20 +334,76 @@ func (j *Job) Validate() error {
 		return fmt.Errorf("name contains illegal character '%s'", chr)
 	}
 
-	if j.ParentJob == j.Name {
+	if j.ParentJob == j.Name || j.hasParent(j.Name) {
 		return ErrSameParent
 	}
 
-	if j.Schedule != "" || j.ParentJob == "" {
-		if _, err := extcron.Parse(j.Schedule); err != nil {
+	if j.ParentJob != "" && len(j.ParentJobs) > 0 {
+		return ErrBothParents
+	}
+
+	switch j.FanIn {
+	case "", FanInAllSucceeded, FanInAnySucceeded, FanInAllFinished:
+	default:
+		return ErrWrongFanIn
+	}
+
+	switch j.TriggerOn {
+	case "", TriggerOnSuccess, TriggerOnFailure, TriggerOnPartialFailure, TriggerOnAlways:
+	default:
+		return ErrWrongTriggerOn
+	}
+
+	if j.TriggerOn != "" && j.ParentJob == "" {
+		return ErrTriggerOnWithoutParent
+	}
+
+	if j.Schedule != "" || !j.isDependent() {
+		if _, err := extcron.ParseHashed(j.Schedule, j.Name); err != nil {
 			return fmt.Errorf("%s: %s", ErrScheduleParse.Error(), err)
 		}
 	}
 
-	if j.Concurrency != j.ConcurrencyAllow && j.Concurrency != j.ConcurrencyForbid && j.Concurrency != "" {
+	if j.Concurrency != ConcurrencyAllow && j.Concurrency != ConcurrencyForbid && j.Concurrency != "" {
 		return ErrWrongConcurrency
 	}
 
+	if j.Timeout != "" {
+		if d, err := time.ParseDuration(j.Timeout); err != nil || d <= 0 {
+			return ErrWrongTimeout
+		}
+	}
+
+	if j.Retention != nil {
+		if err := j.Retention.Validate(); err != nil {
+			return err
+		}
+	}
+
+	switch j.RetryPlacement {
+	case "", RetryPlacementSameNode, RetryPlacementAnyNode, RetryPlacementDifferentNode:
+	default:
+		return ErrWrongPlacement
+	}
+
+	for _, w := range j.AllowedWindows {
+		if err := w.Validate(); err != nil {
+			return err
+		}
+	}
+
+	if j.MissedRunPolicy != nil {
+		if err := j.MissedRunPolicy.Validate(); err != nil {
+			return err
+		}
+	}
+
+	if j.RetryPolicy != nil {
+		if err := j.RetryPolicy.Validate(); err != nil {
+			return err
+		}
+	}
+
 	if _, err := time.LoadLocation(j.Timezone); err != nil {
 		return err
 	}
@@ -275,7 +412,7 @@ func (j *Job) Validate() error {
 }
 
 func isSlug(candidate string) (bool, string) {
-	illegalCharPattern, _ := regexp.Compile(`[\p{Ll}0-9_-]`)
+	illegalCharPattern, _ := regexp.Compile(`[^\p{Ll}0-9_-]`)
 	whyNot := illegalCharPattern.FindString(candidate)
 	return whyNot == "", whyNot
 }
@@ -310,7 +447,7 @@ func findParentJobAndValidateJob(jobs []*Job, index int) ([]*Job, bool, error) {
 		}
 
 		if childJob.ParentJob == parentJob.Name {
-			parentJob.ChildJobs == append(parentJob.ChildJobs, childJob)
+			parentJob.ChildJobs = append(parentJob.ChildJobs, childJob)
 			jobs = append(jobs[:index], jobs[index+1:]...)
 			return jobs, false, nil
 		}
@@ -329,10 +466,8 @@ func findParentJobAndValidateJob(jobs []*Job, index int) ([]*Job, bool, error) {
 func findParentJobInChildJobs(jobs []*Job, job *Job) bool {
 	for _, parentJob := range jobs {
 		if job.ParentJob == parentJob.Name {
-			parentJob.ChildJobs == parentJob.Name {
-				parentJob.ChildJobs = append(parentJob.ChildJobs, job)
-				return true
-			}
+			parentJob.ChildJobs = append(parentJob.ChildJobs, job)
+			return true
 		} else {
 			if len(parentJob.ChildJobs) > 0 {
 				flag := findParentJobInChildJobs(parentJob.ChildJobs, job)
@@ -6,10 +6,9 @@ import (
 	"sync"
 	"time"
 
-	"github.com/apex/log"
 	"github.com/armon/go-metrics"
+	"github.com/hashicorp/raft"
 	"github.com/hashicorp/serf/serf"
-	"golang.org/x/text/cases"
 )
 
 const (
@@ -71,7 +70,7 @@ RECONCILE:
 	metrics.MeasureSince([]string{"spiderjob", "leader", "barrier"}, start)
 
 	if !establishedLeader {
-		if err := a.establishedLeader(stopCh); err != nil {
+		if err := a.establishedLeadership(stopCh); err != nil {
 			log.WithError(err).Error("spiderjob: failed to establish leadership")
 			if err := a.revokeLeadership(); err != nil {
 				log.WithError(err).Error("spiderjob: failed to revoke leadership")
@@ -105,7 +104,7 @@ WAIT:
 			return
 		case <-interval:
 			goto RECONCILE
-		case member := <-reconcileCh
+		case member := <-reconcileCh:
 			a.reconcileMember(member)
 		}
 	}
@@ -151,7 +150,23 @@ func (a *Agent) establishedLeadership(stopCh chan struct{}) error {
 	if err != nil {
 		log.Fatal(err)
 	}
+	// Compute the missed runs before starting the scheduler updates the next runs
+	missed := make(map[*Job][]time.Time)
+	now := time.Now()
+	for _, job := range jobs {
+		if runs := job.MissedRuns(a.Store, now); len(runs) > 0 {
+			missed[job] = runs
+		}
+	}
+
 	a.sched.Start(jobs, a)
+	a.catchUpMissedRuns(missed, stopCh)
+
+	if err := a.restoreRetries(); err != nil {
+		return err
+	}
+
+	go a.compactExecutions(stopCh)
 	return nil
 }
 
@@ -159,6 +174,7 @@ func (a *Agent) establishedLeadership(stopCh chan struct{}) error {
 func (a *Agent) revokeLeadership() error {
 	defer metrics.MeasureSince([]string{"spiderjob", "leader", "revoke_leadership"}, time.Now())
 	a.sched.Stop()
+	a.stopRetries()
 	return nil
 }
 
@@ -168,7 +184,7 @@ func (a *Agent) addRaftPeer(m serf.Member, parts *ServerParts) error {
 		for _, member := range members {
 			valid, p := isServer(member)
 			if valid && member.Name != m.Name && p.Bootstrap {
-				log.Error("spiderjob: '%v' and '%v' are both in bootstrap mode. Only one node should be in bootstrap mode, not adding Raft peer.", m.Name, member.Name)
+				log.Errorf("spiderjob: '%v' and '%v' are both in bootstrap mode. Only one node should be in bootstrap mode, not adding Raft peer.", m.Name, member.Name)
 				return nil
 			}
 		}
@@ -208,7 +224,7 @@ func (a *Agent) addRaftPeer(m serf.Member, parts *ServerParts) error {
 	}
 	switch {
 	case minRaftProtocol >= 3 :
-		addFuture := a.raft.AddVoter(raft.ServerID(parts.ID), raft.ServerAddress(addr), 0, 0, 0)
+		addFuture := a.raft.AddVoter(raft.ServerID(parts.ID), raft.ServerAddress(addr), 0, 0)
 		if err := addFuture.Error(); err != nil {
 			log.WithError(err).Error("spiderjob: failed to add raft peer")
 			return err
@@ -230,7 +246,7 @@ func (a *Agent) removeRaftPeer(m serf.Member, parts *ServerParts) error {
 			log.WithField("server", server.ID).Info("spiderjob: removing server by ID")
 			future := a.raft.RemoveServer(raft.ServerID(parts.ID), 0, 0)
 			if err := future.Error(); err != nil {
-				log.WithError(err).WithError("server", server.ID).Error("spiderjob: failed to remove raft peer")
+				log.WithError(err).WithField("server", server.ID).Error("spiderjob: failed to remove raft peer")
 				return err
 			}
 			break
@@ -21,8 +21,8 @@ func initMetrics(a *Agent) error {
 		fanout = append(fanout, promSink)
 	}
 
-	if a.config.StatsAddr != "" {
-		sink, err := metrics.NewStatsdSink(a.config,.StatsdAddr)
+	if a.config.StatsdAddr != "" {
+		sink, err := metrics.NewStatsdSink(a.config.StatsdAddr)
 		if err != nil {
 			return fmt.Errorf("failed to start started sink. Got: %s", err)
 		}
@@ -32,8 +32,8 @@ func initMetrics(a *Agent) error {
 	if a.config.DogStatsdAddr != "" {
 		var tags []string
 
-		if a.config.DogstatsdTags != nil {
-			tags = a.config.DogstatsdTags
+		if a.config.DogStatsdTags != nil {
+			tags = a.config.DogStatsdTags
 		}
 
 		sink, err := datadog.NewDogStatsdSink(a.config.DogStatsdAddr, a.config.NodeName)
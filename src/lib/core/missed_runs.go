@@ -0,0 +1,160 @@
+package core
+
+import (
+	"errors"
+	"time"
+
+	proto "spiderjob/lib/plugin/types"
+
+	metrics "github.com/armon/go-metrics"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	// MissedRunSkip ignores the runs missed while there was no leader, the default.
+	MissedRunSkip = "skip"
+	// MissedRunOnce runs the job once if any run was missed.
+	MissedRunOnce = "run-once"
+	// MissedRunAll runs the job once for every missed run, up to the policy limit.
+	MissedRunAll = "run-all"
+
+	// maxMissedRuns limits the runs started by run-all policies without a limit.
+	maxMissedRuns = 100
+)
+
+var (
+	// ErrWrongMissedRunPolicy is returned when a missed run policy has invalid values.
+	ErrWrongMissedRunPolicy = errors.New("invalid missed run policy, use a \"skip\", \"run-once\" or \"run-all\" mode, a positive limit and a grace window like \"1h\"")
+)
+
+// MissedRunPolicy defines what to do with the runs of a job missed during
+// a leader election or while the whole cluster was down.
+type MissedRunPolicy struct {
+	// Mode is one of skip, run-once or run-all. Defaults to skip.
+	Mode string `json:"mode"`
+
+	// Maximum number of runs started by run-all, the most recent ones are run.
+	// Defaults to 100.
+	Limit int `json:"limit"`
+
+	// Only runs missed within this window before the leader started are
+	// caught up, like "1h". Empty means no limit.
+	GraceWindow string `json:"grace_window"`
+}
+
+// NewMissedRunPolicyFromProto maps a proto.MissedRunPolicy to a MissedRunPolicy.
+func NewMissedRunPolicyFromProto(in *proto.MissedRunPolicy) *MissedRunPolicy {
+	if in == nil {
+		return nil
+	}
+	return &MissedRunPolicy{
+		Mode:        in.Mode,
+		Limit:       int(in.Limit),
+		GraceWindow: in.GraceWindow,
+	}
+}
+
+// ToProto returns the protobuf struct corresponding to the policy.
+func (p *MissedRunPolicy) ToProto() *proto.MissedRunPolicy {
+	if p == nil {
+		return nil
+	}
+	return &proto.MissedRunPolicy{
+		Mode:        p.Mode,
+		Limit:       int32(p.Limit),
+		GraceWindow: p.GraceWindow,
+	}
+}
+
+// Validate checks the policy values.
+func (p *MissedRunPolicy) Validate() error {
+	switch p.Mode {
+	case "", MissedRunSkip, MissedRunOnce, MissedRunAll:
+	default:
+		return ErrWrongMissedRunPolicy
+	}
+	if p.Limit < 0 {
+		return ErrWrongMissedRunPolicy
+	}
+	if p.GraceWindow != "" {
+		if d, err := time.ParseDuration(p.GraceWindow); err != nil || d <= 0 {
+			return ErrWrongMissedRunPolicy
+		}
+	}
+	return nil
+}
+
+// MissedRuns returns the fire times between the stored next run and now
+// that the missed run policy of the job catches up, oldest first.
+// Runs on the days excluded by the job calendars are not caught up.
+func (j *Job) MissedRuns(store Storage, now time.Time) []time.Time {
+	p := j.MissedRunPolicy
+	if p == nil || p.Mode == "" || p.Mode == MissedRunSkip {
+		return nil
+	}
+	if j.Disabled || j.isDependent() || j.Next.IsZero() || j.Next.After(now) {
+		return nil
+	}
+
+	sched, err := j.cronSchedule(store)
+	if err != nil {
+		return nil
+	}
+	// Overdue after-completion runs are armed right away by the scheduler
+	if _, ok := afterCompletion(sched); ok {
+		return nil
+	}
+
+	var since time.Time
+	if p.GraceWindow != "" {
+		d, _ := time.ParseDuration(p.GraceWindow)
+		since = now.Add(-d)
+	}
+	limit := p.Limit
+	if limit <= 0 {
+		limit = maxMissedRuns
+	}
+	if p.Mode == MissedRunOnce {
+		limit = 1
+	}
+
+	// Start at the grace window instead of walking all the runs before it
+	start := j.Next
+	if start.Before(since) {
+		start = sched.Next(since.Add(-time.Nanosecond))
+	}
+
+	var missed []time.Time
+	for t := start; !t.IsZero() && !t.After(now); t = sched.Next(t) {
+		missed = append(missed, t)
+		// Keep only the most recent ones
+		if len(missed) > limit {
+			missed = missed[1:]
+		}
+	}
+	return missed
+}
+
+// catchUpMissedRuns runs the jobs once for every missed run, the runs of
+// each job are started one after the other until the leadership is lost.
+func (a *Agent) catchUpMissedRuns(missed map[*Job][]time.Time, stopCh chan struct{}) {
+	for job, runs := range missed {
+		go func(job *Job, runs []time.Time) {
+			for _, t := range runs {
+				select {
+				case <-stopCh:
+					return
+				case <-a.shutdownCh:
+					return
+				default:
+				}
+				log.WithFields(logrus.Fields{
+					"job":       job.Name,
+					"scheduled": t,
+				}).Info("agent: Running missed job")
+				metrics.IncrCounterWithLabels([]string{"agent", "missed_run"}, 1, []metrics.Label{{Name: "job", Value: job.Name}})
+				job.Run()
+			}
+		}(job, runs)
+	}
+}
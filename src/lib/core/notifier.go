@@ -3,29 +3,30 @@ package core
 import (
 	"bytes"
 	"fmt"
+	"github.com/jordan-wright/email"
+	"github.com/sirupsen/logrus"
 	"io/ioutil"
 	"net/http"
+	"net/smtp"
 	"net/textproto"
 	"strings"
 	"text/template"
 	"time"
-	"github.com/jordan-wright/email"
-	"github.com/sirupsen/logrus"
 )
 
 type Notifier struct {
-	Config *Config
-	Job *Job
-	Execution *Execution
+	Config         *Config
+	Job            *Job
+	Execution      *Execution
 	ExecutionGroup []*Execution
 }
 
-func Notification(config *Config, execution *Execution, exGroup *ExecutionGroup, job *Job) *Notifier {
+func Notification(config *Config, execution *Execution, exGroup []*Execution, job *Job) *Notifier {
 	return &Notifier{
-		Config: config,
-		Execution: execution,
+		Config:         config,
+		Execution:      execution,
 		ExecutionGroup: exGroup,
-		Job: job,
+		Job:            job,
 	}
 }
 
@@ -36,7 +37,7 @@ func (n *Notifier) Send() error {
 
 	if n.Config.WebhookURL != "" && n.Config.WebhookPayload != "" {
 		return n.callExecutionWebhook()
-	} 
+	}
 
 	return nil
 }
@@ -44,26 +45,30 @@ func (n *Notifier) Send() error {
 func (n *Notifier) report() string {
 	var exgStr string
 	for _, ex := range n.ExecutionGroup {
-		exgStr = fmt.Sprintf("%s\t[Note]: %s [Start]: %s [End]: %s [Success]: %t\n", 
-		exgStr,
-		ex.NodeName,
-		ex.FinishedAt,
-		ex.Success
+		exgStr = fmt.Sprintf("%s\t[Note]: %s [Start]: %s [End]: %s [Success]: %t [TimedOut]: %t\n",
+			exgStr,
+			ex.NodeName,
+			ex.StartedAt,
+			ex.FinishedAt,
+			ex.Success,
+			ex.TimedOut,
 		)
 	}
+	return exgStr
 }
 
 func (n *Notifier) buildTemplate(templ string) *bytes.Buffer {
 	t := template.Must(template.New("report").Parse(templ))
 	data := struct {
-		Report string
-		JobName string
+		Report        string
+		JobName       string
 		ReportingNode string
-		StartTime time.Time
-		FinishedAt time.Time
-		Success string
-		NodeName string
-		Output string
+		StartTime     time.Time
+		FinishedAt    time.Time
+		Success       string
+		TimedOut      string
+		NodeName      string
+		Output        string
 	}{
 		n.report(),
 		n.Execution.JobName,
@@ -71,6 +76,7 @@ func (n *Notifier) buildTemplate(templ string) *bytes.Buffer {
 		n.Execution.StartedAt,
 		n.Execution.FinishedAt,
 		fmt.Sprintf("%t", n.Execution.Success),
+		fmt.Sprintf("%t", n.Execution.TimedOut),
 		n.Execution.NodeName,
 		n.Execution.Output,
 	}
@@ -89,14 +95,14 @@ func (n *Notifier) SendExecutionEmail() error {
 	if n.Config.MailPayload != "" {
 		data = n.buildTemplate(n.Config.MailPayload)
 	} else {
-		data = bytes.NewBuffer([]byte(n.Execution.output))
+		data = bytes.NewBuffer([]byte(n.Execution.Output))
 	}
 
 	e := &email.Email{
-		To: []string{n.Job.OwnerEmail},
-		From: n.Config.MailFrom,
+		To:      []string{n.Job.OwnerEmail},
+		From:    n.Config.MailFrom,
 		Subject: fmt.Sprintf("%s%s %s execution report", n.Config.MailSubjectPrefix, n.statusString(n.Execution), n.Execution.JobName),
-		Text: []byte(data.Bytes()),
+		Text:    []byte(data.Bytes()),
 		Headers: textproto.MIMEHeader{},
 	}
 
@@ -109,8 +115,8 @@ func (n *Notifier) SendExecutionEmail() error {
 
 func (n *Notifier) auth() smtp.Auth {
 	var auth smtp.Auth
-	if n.Config.MailUserName != "" && n.Config.MailPassword != "" {
-		auth = smtp.PlainAuth("", n.Config.MailUserName, n.Config.MailPassword, n.Config.MailHost)
+	if n.Config.MailUsername != "" && n.Config.MailPassword != "" {
+		auth = smtp.PlainAuth("", n.Config.MailUsername, n.Config.MailPassword, n.Config.MailHost)
 	}
 
 	return auth
@@ -141,7 +147,7 @@ func (n *Notifier) callExecutionWebhook() error {
 	log.WithFields(logrus.Fields{
 		"status": resp.Status,
 		"header": resp.Header,
-		"body": string(body),
+		"body":   string(body),
 	}).Debug("notifier: Webhook call responese")
 
 	return nil
@@ -151,5 +157,8 @@ func (n *Notifier) statusString(execution *Execution) string {
 	if execution.Success {
 		return "Success"
 	}
+	if execution.TimedOut {
+		return "Timed out"
+	}
 	return "Failed"
-}
\ No newline at end of file
+}
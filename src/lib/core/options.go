@@ -12,7 +12,7 @@ func WithPlugins(plugins Plugins) AgentOption {
 }
 
 func WithTransportCredentials(tls *tls.Config) AgentOption {
-	return func(agent &Agent) {
+	return func(agent *Agent) {
 		agent.TLSConfig = tls
 	}
 }
@@ -0,0 +1,78 @@
+package core
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+	"strings"
+	"text/template"
+
+	"spiderjob/lib/plugin"
+)
+
+// parentTemplateData is the data the executor config of a dependent job is rendered with.
+type parentTemplateData struct {
+	Parent struct {
+		// Name of the parent job whose run triggered the execution.
+		Name string
+
+		// Results of the parent run, for jobs with parent jobs the
+		// merged results of the runs of all the parents.
+		Outputs map[string]string
+	}
+}
+
+// groupResults merges the results of the executions of a group, sorted
+// by node name so the same result set by several nodes is stable.
+func groupResults(group []*Execution) map[string]string {
+	sorted := make([]*Execution, len(group))
+	copy(sorted, group)
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].NodeName < sorted[j].NodeName
+	})
+
+	results := make(map[string]string)
+	for _, e := range sorted {
+		for name, value := range e.Results {
+			results[name] = value
+		}
+	}
+	return results
+}
+
+// renderExecutorConfig renders the templates in the executor config values with
+// the outputs of the parent run that triggered the execution, like
+// "{{ .Parent.Outputs.file_path }}". Executions not triggered by a parent
+// run keep the config as is.
+func renderExecutorConfig(config plugin.ExecutorPluginConfig, ex *Execution) (plugin.ExecutorPluginConfig, error) {
+	if ex.TriggeredBy == "" {
+		return config, nil
+	}
+
+	var data parentTemplateData
+	data.Parent.Name = ex.TriggeredBy
+	data.Parent.Outputs = ex.ParentOutputs
+	if data.Parent.Outputs == nil {
+		data.Parent.Outputs = map[string]string{}
+	}
+
+	rendered := make(plugin.ExecutorPluginConfig, len(config))
+	for k, v := range config {
+		if !strings.Contains(v, "{{") {
+			rendered[k] = v
+			continue
+		}
+
+		// Missing outputs fail the run instead of running with empty values
+		tmpl, err := template.New(k).Option("missingkey=error").Parse(v)
+		if err != nil {
+			return nil, fmt.Errorf("executor config %s: %s", k, err)
+		}
+		var buf bytes.Buffer
+		if err := tmpl.Execute(&buf, data); err != nil {
+			return nil, fmt.Errorf("executor config %s: %s", k, err)
+		}
+		rendered[k] = buf.String()
+	}
+	return rendered, nil
+}
@@ -6,7 +6,6 @@ import (
 	"net"
 	"time"
 
-	"github.com/apex/log"
 	"github.com/hashicorp/raft"
 )
 
@@ -19,10 +18,16 @@ func NewRaftLayer() *RaftLayer {
 	return &RaftLayer{}
 }
 
-func NewTLSRafterLayer(tlsConfig *tls.Config) *RaftLayer {
+func NewTLSRaftLayer(tlsConfig *tls.Config) *RaftLayer {
 	return &RaftLayer{TLSConfig: tlsConfig}
 }
 
+// Open uses the listener for the incoming raft connections.
+func (t *RaftLayer) Open(l net.Listener) error {
+	t.ln = l
+	return nil
+}
+
 func (t *RaftLayer) Dial(addr raft.ServerAddress, timeout time.Duration) (net.Conn, error) {
 	dialer := &net.Dialer{Timeout: timeout}
 	var err error
@@ -0,0 +1,206 @@
+package core
+
+import (
+	"errors"
+	"sort"
+	"time"
+
+	proto "spiderjob/lib/plugin/types"
+
+	metrics "github.com/armon/go-metrics"
+	"github.com/tidwall/buntdb"
+)
+
+var (
+	// ErrWrongRetention is returned when a retention policy has invalid values.
+	ErrWrongRetention = errors.New("invalid retention policy, use a positive max_executions and a max_age duration like \"720h\"")
+)
+
+// RetentionPolicy defines which executions of a job are kept in the store,
+// executions exceeding any of the limits are deleted by the leader.
+type RetentionPolicy struct {
+	// Number of executions to keep, 0 means no limit.
+	MaxExecutions int `json:"max_executions"`
+
+	// Executions started before this duration are deleted, like "720h". Empty means no limit.
+	MaxAge string `json:"max_age"`
+
+	// Always keep the last failed execution.
+	KeepLastFailure bool `json:"keep_last_failure"`
+
+	// Always keep the last successful execution.
+	KeepLastSuccess bool `json:"keep_last_success"`
+}
+
+// NewRetentionPolicyFromProto maps a proto.RetentionPolicy to a RetentionPolicy.
+func NewRetentionPolicyFromProto(in *proto.RetentionPolicy) *RetentionPolicy {
+	if in == nil {
+		return nil
+	}
+	return &RetentionPolicy{
+		MaxExecutions:   int(in.MaxExecutions),
+		MaxAge:          in.MaxAge,
+		KeepLastFailure: in.KeepLastFailure,
+		KeepLastSuccess: in.KeepLastSuccess,
+	}
+}
+
+// ToProto returns the protobuf struct corresponding to the policy.
+func (p *RetentionPolicy) ToProto() *proto.RetentionPolicy {
+	if p == nil {
+		return nil
+	}
+	return &proto.RetentionPolicy{
+		MaxExecutions:   int32(p.MaxExecutions),
+		MaxAge:          p.MaxAge,
+		KeepLastFailure: p.KeepLastFailure,
+		KeepLastSuccess: p.KeepLastSuccess,
+	}
+}
+
+// Validate checks the policy limits.
+func (p *RetentionPolicy) Validate() error {
+	if p.MaxExecutions < 0 {
+		return ErrWrongRetention
+	}
+	if p.MaxAge != "" {
+		if d, err := time.ParseDuration(p.MaxAge); err != nil || d <= 0 {
+			return ErrWrongRetention
+		}
+	}
+	return nil
+}
+
+// GetMaxAge returns the max age as a duration, 0 means no limit.
+func (p *RetentionPolicy) GetMaxAge() time.Duration {
+	if p.MaxAge == "" {
+		return 0
+	}
+	d, _ := time.ParseDuration(p.MaxAge)
+	return d
+}
+
+// Expired returns the executions not kept by the policy. Running executions,
+// the ones with their key in running, are always kept and don't count towards
+// the limit. Unfinished executions not running anymore crashed before finishing,
+// they are handled as the others.
+func (p *RetentionPolicy) Expired(executions []*Execution, running map[string]bool, now time.Time) []*Execution {
+	finished := make([]*Execution, 0, len(executions))
+	for _, e := range executions {
+		if !e.FinishedAt.IsZero() || !running[e.Key()] {
+			finished = append(finished, e)
+		}
+	}
+	// Newest first
+	sort.Slice(finished, func(i, j int) bool {
+		return finished[i].StartedAt.After(finished[j].StartedAt)
+	})
+
+	var lastFailure, lastSuccess *Execution
+	for _, e := range ranExecutions(finished) {
+		if e.Success && lastSuccess == nil {
+			lastSuccess = e
+		}
+		if !e.Success && lastFailure == nil {
+			lastFailure = e
+		}
+	}
+
+	maxAge := p.GetMaxAge()
+	var expired []*Execution
+	for i, e := range finished {
+		if (p.KeepLastFailure && e == lastFailure) || (p.KeepLastSuccess && e == lastSuccess) {
+			continue
+		}
+		if (p.MaxExecutions > 0 && i >= p.MaxExecutions) || (maxAge > 0 && now.Sub(e.StartedAt) > maxAge) {
+			expired = append(expired, e)
+		}
+	}
+	return expired
+}
+
+// compactExecutions periodically deletes the executions not kept by the
+// retention policies, it runs in the leader until the leadership is lost.
+func (a *Agent) compactExecutions(stopCh chan struct{}) {
+	if a.config.RetentionInterval <= 0 {
+		return
+	}
+	ticker := time.NewTicker(a.config.RetentionInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			if err := a.compact(); err != nil {
+				log.WithError(err).Error("agent: Error compacting executions")
+			}
+		case <-stopCh:
+			return
+		case <-a.shutdownCh:
+			return
+		}
+	}
+}
+
+// compact applies the retention policy of every job, the job policy
+// replaces the global one from the agent config when it's defined.
+func (a *Agent) compact() error {
+	defer metrics.MeasureSince([]string{"agent", "compact_executions"}, time.Now())
+
+	jobs, err := a.Store.GetJobs(nil)
+	if err != nil {
+		return err
+	}
+
+	active, err := a.GetActiveExecutions()
+	if err != nil {
+		return err
+	}
+	running := make(map[string]bool, len(active))
+	for _, e := range active {
+		running[e.Key()] = true
+	}
+
+	global := a.config.RetentionPolicy()
+	now := time.Now()
+	for _, job := range jobs {
+		policy := global
+		if job.Retention != nil {
+			policy = job.Retention
+		}
+
+		executions, err := a.Store.GetExecutions(job.Name, &ExecutionOptions{})
+		if err == buntdb.ErrNotFound {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+
+		expired := policy.Expired(executions, running, now)
+		if len(expired) == 0 {
+			continue
+		}
+
+		ids := make([]string, 0, len(expired))
+		for _, e := range expired {
+			ids = append(ids, e.Key())
+		}
+		cmd, err := Encode(DeleteExecutionsType, &proto.DeleteExecutionsRequest{
+			JobName:      job.Name,
+			ExecutionIds: ids,
+		})
+		if err != nil {
+			return err
+		}
+		if err := a.raft.Apply(cmd, raftTimeout).Error(); err != nil {
+			return err
+		}
+		a.deleteExecutionLogs(job.Name, expired)
+
+		log.WithField("job", job.Name).WithField("count", len(ids)).Debug("agent: Deleted executions not retained")
+		metrics.IncrCounterWithLabels([]string{"agent", "executions_compacted"}, float32(len(ids)), []metrics.Label{{Name: "job", Value: job.Name}})
+	}
+
+	return nil
+}
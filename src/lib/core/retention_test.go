@@ -0,0 +1,102 @@
+package core
+
+import (
+	"testing"
+	"time"
+)
+
+func TestRetentionPolicyExpired(t *testing.T) {
+	now := time.Date(2024, 1, 2, 12, 0, 0, 0, time.UTC)
+
+	// Newest first, one minute apart
+	at := func(i int) time.Time { return now.Add(-time.Duration(i+1) * time.Minute) }
+	ok := func(i int) *Execution { return testExecution("job1", at(i), true) }
+	failed := func(i int) *Execution { return testExecution("job1", at(i), false) }
+	skipped := func(i int) *Execution {
+		e := failed(i)
+		e.Skipped = true
+		e.SkipReason = SkipOutsideWindow
+		return e
+	}
+	unfinished := func(i int) *Execution {
+		e := ok(i)
+		e.FinishedAt = time.Time{}
+		return e
+	}
+
+	tests := []struct {
+		name       string
+		policy     RetentionPolicy
+		executions []*Execution
+		running    []int
+		want       []int
+	}{
+		{
+			name:       "no limits",
+			policy:     RetentionPolicy{},
+			executions: []*Execution{ok(0), ok(1), failed(2)},
+		},
+		{
+			name:       "max executions",
+			policy:     RetentionPolicy{MaxExecutions: 2},
+			executions: []*Execution{ok(0), ok(1), failed(2), ok(3)},
+			want:       []int{2, 3},
+		},
+		{
+			name:       "max age",
+			policy:     RetentionPolicy{MaxAge: "150s"},
+			executions: []*Execution{ok(0), ok(1), ok(2), ok(3)},
+			want:       []int{2, 3},
+		},
+		{
+			name:       "keep last failure",
+			policy:     RetentionPolicy{MaxExecutions: 1, KeepLastFailure: true},
+			executions: []*Execution{ok(0), ok(1), failed(2), failed(3)},
+			want:       []int{1, 3},
+		},
+		{
+			name:       "keep last success",
+			policy:     RetentionPolicy{MaxExecutions: 1, KeepLastSuccess: true},
+			executions: []*Execution{failed(0), ok(1), ok(2)},
+			want:       []int{2},
+		},
+		{
+			name:       "skipped runs aren't the last failure",
+			policy:     RetentionPolicy{MaxExecutions: 1, KeepLastFailure: true},
+			executions: []*Execution{ok(0), skipped(1), failed(2)},
+			want:       []int{1},
+		},
+		{
+			name:       "running executions are kept",
+			policy:     RetentionPolicy{MaxExecutions: 1},
+			executions: []*Execution{unfinished(0), ok(1), ok(2)},
+			running:    []int{0},
+			want:       []int{2},
+		},
+		{
+			name:       "crashed executions expire",
+			policy:     RetentionPolicy{MaxExecutions: 1},
+			executions: []*Execution{ok(0), unfinished(1), unfinished(2)},
+			want:       []int{1, 2},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			running := make(map[string]bool)
+			for _, i := range tt.running {
+				running[tt.executions[i].Key()] = true
+			}
+
+			got := tt.policy.Expired(tt.executions, running, now)
+			if len(got) != len(tt.want) {
+				t.Fatalf("got %d expired executions, want %d", len(got), len(tt.want))
+			}
+			for i, e := range got {
+				if e != tt.executions[tt.want[i]] {
+					t.Fatalf("expired execution %d started at %s, want %s", i, e.StartedAt, tt.executions[tt.want[i]].StartedAt)
+				}
+			}
+		})
+	}
+}
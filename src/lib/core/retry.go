@@ -0,0 +1,327 @@
+package core
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"math/rand"
+	"time"
+
+	proto "spiderjob/lib/plugin/types"
+
+	"github.com/golang/protobuf/ptypes"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	// BackoffFixed waits the same delay before every retry.
+	BackoffFixed = "fixed"
+	// BackoffLinear multiplies the delay by the number of failed attempts.
+	BackoffLinear = "linear"
+	// BackoffExponential doubles the delay after every failed attempt.
+	BackoffExponential = "exponential"
+
+	// retryRunDelay is the wait before trying again a retry that failed to run.
+	retryRunDelay = 30 * time.Second
+)
+
+var (
+	// ErrWrongRetryPolicy is returned when a retry policy has invalid values.
+	ErrWrongRetryPolicy = errors.New("invalid retry policy, use a \"fixed\", \"linear\" or \"exponential\" backoff, positive delays like \"30s\" and a jitter between 0 and 1")
+)
+
+// RetryPolicy defines how long to wait before retrying a failed execution
+// and which failures are retried. Jobs without a policy retry immediately.
+type RetryPolicy struct {
+	// Backoff strategy, one of fixed, linear or exponential. Defaults to fixed.
+	Backoff string `json:"backoff"`
+
+	// Base delay before retrying, like "30s".
+	Delay string `json:"delay"`
+
+	// Upper limit of the delay, empty means no limit.
+	MaxDelay string `json:"max_delay"`
+
+	// Fraction of the delay randomly added or removed, between 0 and 1.
+	Jitter float64 `json:"jitter"`
+
+	// Failure categories that are retried, empty means all of them.
+	RetryOn []string `json:"retry_on"`
+}
+
+// NewRetryPolicyFromProto maps a proto.RetryPolicy to a RetryPolicy.
+func NewRetryPolicyFromProto(in *proto.RetryPolicy) *RetryPolicy {
+	if in == nil {
+		return nil
+	}
+	return &RetryPolicy{
+		Backoff:  in.Backoff,
+		Delay:    in.Delay,
+		MaxDelay: in.MaxDelay,
+		Jitter:   in.Jitter,
+		RetryOn:  in.RetryOn,
+	}
+}
+
+// ToProto returns the protobuf struct corresponding to the policy.
+func (p *RetryPolicy) ToProto() *proto.RetryPolicy {
+	if p == nil {
+		return nil
+	}
+	return &proto.RetryPolicy{
+		Backoff:  p.Backoff,
+		Delay:    p.Delay,
+		MaxDelay: p.MaxDelay,
+		Jitter:   p.Jitter,
+		RetryOn:  p.RetryOn,
+	}
+}
+
+// Validate checks the policy values.
+func (p *RetryPolicy) Validate() error {
+	switch p.Backoff {
+	case "", BackoffFixed, BackoffLinear, BackoffExponential:
+	default:
+		return ErrWrongRetryPolicy
+	}
+	for _, v := range []string{p.Delay, p.MaxDelay} {
+		if v == "" {
+			continue
+		}
+		if d, err := time.ParseDuration(v); err != nil || d <= 0 {
+			return ErrWrongRetryPolicy
+		}
+	}
+	if p.Jitter < 0 || p.Jitter > 1 {
+		return ErrWrongRetryPolicy
+	}
+	for _, c := range p.RetryOn {
+		switch c {
+		case FailureExecutorMissing, FailureTimeout, FailureNodeLost, FailureNonZeroExit, FailureCancelled, FailureExecutorError:
+		default:
+			return fmt.Errorf("%s: unknown failure category %q", ErrWrongRetryPolicy, c)
+		}
+	}
+	return nil
+}
+
+// ShouldRetry returns if the failure of the execution is retried by the policy.
+func (p *RetryPolicy) ShouldRetry(execution *Execution) bool {
+	if p == nil || len(p.RetryOn) == 0 {
+		return true
+	}
+	for _, c := range p.RetryOn {
+		if c == execution.FailureCategory {
+			return true
+		}
+	}
+	return false
+}
+
+// GetDelay returns how long to wait before retrying after the given
+// number of failed attempts.
+func (p *RetryPolicy) GetDelay(attempt uint) time.Duration {
+	if p == nil || p.Delay == "" || attempt < 1 {
+		return 0
+	}
+	base, _ := time.ParseDuration(p.Delay)
+	maxDelay, _ := time.ParseDuration(p.MaxDelay)
+
+	d := base
+	switch p.Backoff {
+	case BackoffLinear:
+		d = base * time.Duration(attempt)
+	case BackoffExponential:
+		for i := uint(1); i < attempt; i++ {
+			// Stop doubling once over the limit or before overflowing
+			if (maxDelay > 0 && d >= maxDelay) || d > math.MaxInt64/2 {
+				break
+			}
+			d *= 2
+		}
+	}
+
+	if p.Jitter > 0 {
+		d += time.Duration(float64(d) * p.Jitter * (2*rand.Float64() - 1))
+	}
+	if maxDelay > 0 && d > maxDelay {
+		d = maxDelay
+	}
+	if d < 0 {
+		d = 0
+	}
+	return d
+}
+
+// PendingRetry is a retry of a failed execution scheduled by the leader,
+// it's stored in the replicated state so it survives a leader change.
+type PendingRetry struct {
+	// Execution to run, with the attempt already incremented.
+	Execution *Execution `json:"execution"`
+
+	// When the retry runs.
+	RunAt time.Time `json:"run_at"`
+}
+
+// NewPendingRetryFromProto maps a proto.PendingRetry to a PendingRetry.
+func NewPendingRetryFromProto(in *proto.PendingRetry) *PendingRetry {
+	runAt, _ := ptypes.Timestamp(in.GetRunAt())
+	return &PendingRetry{
+		Execution: NewExecutionFromProto(in.Execution),
+		RunAt:     runAt,
+	}
+}
+
+// ToProto returns the protobuf struct corresponding to the retry.
+func (r *PendingRetry) ToProto() *proto.PendingRetry {
+	runAt, _ := ptypes.TimestampProto(r.RunAt)
+	return &proto.PendingRetry{
+		Execution: r.Execution.ToProto(),
+		RunAt:     runAt,
+	}
+}
+
+// Key returns the key of the retry, the key of the failed execution.
+func (r *PendingRetry) Key() string {
+	return r.Execution.Key()
+}
+
+// scheduleRetry stores the retry in the replicated state and arms its timer.
+// This only works on the leader.
+func (a *Agent) scheduleRetry(retry *PendingRetry) error {
+	cmd, err := Encode(SetPendingRetryType, retry.ToProto())
+	if err != nil {
+		return err
+	}
+	if err := a.raft.Apply(cmd, raftTimeout).Error(); err != nil {
+		return err
+	}
+
+	log.WithFields(logrus.Fields{
+		"job":     retry.Execution.JobName,
+		"attempt": retry.Execution.Attempt,
+		"run_at":  retry.RunAt,
+	}).Debug("agent: Retry scheduled")
+	a.armRetry(retry)
+	return nil
+}
+
+// armRetry runs the retry once it's due.
+func (a *Agent) armRetry(retry *PendingRetry) {
+	a.retryLock.Lock()
+	defer a.retryLock.Unlock()
+
+	if a.retryTimers == nil {
+		a.retryTimers = make(map[string]*time.Timer)
+	}
+	if t, ok := a.retryTimers[retry.Key()]; ok {
+		t.Stop()
+	}
+	a.retryTimers[retry.Key()] = time.AfterFunc(time.Until(retry.RunAt), func() {
+		a.runRetry(retry)
+	})
+}
+
+// runRetry runs the retry and removes it from the replicated state once it
+// was dispatched, so a failure to run keeps it for a later attempt. Retries
+// started by a previous leader are found by their executions and not run again.
+func (a *Agent) runRetry(retry *PendingRetry) {
+	a.retryLock.Lock()
+	delete(a.retryTimers, retry.Key())
+	a.retryLock.Unlock()
+
+	// The new leader will run it
+	if !a.IsLeader() {
+		return
+	}
+
+	job, err := a.Store.GetJob(retry.Execution.JobName, nil)
+	if err != nil {
+		log.WithError(err).WithField("job", retry.Execution.JobName).Error("agent: Error getting job of pending retry")
+		return
+	}
+
+	// A previous leader may have failed over while the retry was running
+	if a.retryDispatched(retry) {
+		log.WithFields(logrus.Fields{
+			"job":     retry.Execution.JobName,
+			"attempt": retry.Execution.Attempt,
+		}).Debug("agent: Retry already dispatched")
+		a.deletePendingRetry(retry)
+		return
+	}
+
+	// Retries respect the allowed windows like any run
+	if !job.inAllowedWindow(time.Now()) {
+		a.recordSkipped(job, SkipOutsideWindow)
+		a.deletePendingRetry(retry)
+		return
+	}
+
+	log.WithFields(logrus.Fields{
+		"job":     retry.Execution.JobName,
+		"attempt": retry.Execution.Attempt,
+	}).Debug("agent: Retrying execution")
+	if _, err := a.Run(retry.Execution.JobName, retry.Execution); err != nil {
+		log.WithError(err).WithField("job", retry.Execution.JobName).Error("agent: Error retrying execution")
+		retry.RunAt = time.Now().Add(retryRunDelay)
+		a.armRetry(retry)
+		return
+	}
+	a.deletePendingRetry(retry)
+}
+
+// retryDispatched returns if an execution of the retry attempt is already
+// stored, the retry was started before the leadership changed.
+func (a *Agent) retryDispatched(retry *PendingRetry) bool {
+	group, err := a.Store.GetExecutionGroup(retry.Execution, &ExecutionOptions{})
+	if err != nil {
+		return false
+	}
+	for _, e := range group {
+		if e.Attempt >= retry.Execution.Attempt {
+			return true
+		}
+	}
+	return false
+}
+
+// deletePendingRetry removes the retry from the replicated state.
+func (a *Agent) deletePendingRetry(retry *PendingRetry) {
+	cmd, err := Encode(DeletePendingRetryType, &proto.DeletePendingRetryRequest{
+		JobName:     retry.Execution.JobName,
+		ExecutionId: retry.Key(),
+	})
+	if err != nil {
+		log.WithError(err).Error("agent: Error encoding pending retry")
+		return
+	}
+	if err := a.raft.Apply(cmd, raftTimeout).Error(); err != nil {
+		log.WithError(err).WithField("job", retry.Execution.JobName).Error("agent: Error deleting pending retry")
+	}
+}
+
+// restoreRetries arms the timers of the retries scheduled by previous
+// leaders, the ones already due run right away.
+func (a *Agent) restoreRetries() error {
+	retries, err := a.Store.GetPendingRetries()
+	if err != nil {
+		return err
+	}
+	for _, r := range retries {
+		a.armRetry(r)
+	}
+	return nil
+}
+
+// stopRetries stops the retry timers when the leadership is lost,
+// the retries are kept in the store for the next leader.
+func (a *Agent) stopRetries() {
+	a.retryLock.Lock()
+	defer a.retryLock.Unlock()
+
+	for k, t := range a.retryTimers {
+		t.Stop()
+		delete(a.retryTimers, k)
+	}
+}
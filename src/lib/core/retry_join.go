@@ -20,7 +20,7 @@ type retryJoiner struct {
 func (a *Agent) retryJoinLAN() {
 	r := &retryJoiner{
 		cluster: "LAN",
-		addrs: a.config.retryJoinLAN,
+		addrs: a.config.RetryJoinLAN,
 		maxAttempts: a.config.RetryJoinMaxAttemptsLAN,
 		interval: a.config.RetryJoinIntervalLAN,
 		join: a.JoinLAN,
@@ -64,7 +64,7 @@ func (r *retryJoiner) retryJoin() error {
 				if err != nil {
 					log.WithError(err).WithField("cluster", r.cluster).Error("agent: Error Joining")
 				} else {
-					addr = append(addrs, servers...)
+					addrs = append(addrs, servers...)
 					log.Infof("agent: Discovered %s servers: %s", r.cluster, strings.Join(servers, " "))
 				}
 			default:
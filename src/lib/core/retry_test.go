@@ -0,0 +1,41 @@
+package core
+
+import (
+	"testing"
+	"time"
+)
+
+func TestRetryDispatched(t *testing.T) {
+	s, err := NewStore()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer s.Shutdown()
+	a := &Agent{Store: s}
+
+	if err := s.SetJob(testJob("job1"), false); err != nil {
+		t.Fatal(err)
+	}
+	start := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	failed := testExecution("job1", start, false)
+	failed.Attempt = 1
+	if _, err := s.SetExecutionDone(failed); err != nil {
+		t.Fatal(err)
+	}
+
+	ex := testExecution("job1", start.Add(time.Minute), false)
+	ex.Group = failed.Group
+	ex.Attempt = 2
+	retry := &PendingRetry{Execution: ex, RunAt: ex.StartedAt}
+	if a.retryDispatched(retry) {
+		t.Fatal("retry not started reported as dispatched")
+	}
+
+	// The retry started before the leader changed
+	if _, err := s.SetExecution(ex); err != nil {
+		t.Fatal(err)
+	}
+	if !a.retryDispatched(retry) {
+		t.Fatal("started retry not reported as dispatched")
+	}
+}
@@ -2,9 +2,9 @@ package core
 
 import (
 	"fmt"
-	"sync"
 	"github.com/hashicorp/serf/serf"
 	"github.com/sirupsen/logrus"
+	"sync"
 )
 
 func (a *Agent) Run(jobName string, ex *Execution) (*Job, error) {
@@ -13,7 +13,7 @@ func (a *Agent) Run(jobName string, ex *Execution) (*Job, error) {
 		return nil, fmt.Errorf("agent: Run error retrieving job: %s from store: %w", jobName, err)
 	}
 
-	if job.ParentJob == "" {
+	if !job.isDependent() {
 		if e, ok := a.sched.GetEntry(jobName); ok {
 			job.Next = e.Next
 			if err := a.applySetJob(job.ToProto()); err != nil {
@@ -24,24 +24,22 @@ func (a *Agent) Run(jobName string, ex *Execution) (*Job, error) {
 		}
 	}
 
+	// Dependent jobs get the outputs of the parent run in their executor config
+	if job.ExecutorConfig, err = renderExecutorConfig(job.ExecutorConfig, ex); err != nil {
+		return nil, fmt.Errorf("agent: Run error rendering job %s: %w", jobName, err)
+	}
+
 	var filterMap map[string]string
 	if ex.Attempt <= 1 {
-		filterMap, _, err := a.processFilteredNodes(job)
+		filterMap, _, err = a.processFilteredNodes(job, nil)
 		if err != nil {
 			return nil, fmt.Errorf("run error processing filtered nodes: %w", err)
 		}
 	} else {
-		var addr string
-		for _, m := range a.serf.Members() {
-			if ex.NodeName == m.Name {
-				if m.Status ==serf.StatusAlive {
-					addr = m.Tags["rpc_addr"]
-				} else {
-					return nil, fmt.Errorf("retry node is gone: %s for job %s", ex.NodeName, ex.JobName)
-				}
-			}
+		filterMap, err = a.retryNodes(job, ex)
+		if err != nil {
+			return nil, err
 		}
-		filterMap = map[string]string{ex.NodeName: addr}
 	}
 
 	if len(filterMap) < 1 {
@@ -55,17 +53,64 @@ func (a *Agent) Run(jobName string, ex *Execution) (*Job, error) {
 			defer wg.Done()
 			log.WithFields(logrus.Fields{
 				"job_name": job.Name,
-				"node": node,
+				"node":     node,
 			}).Info("agent: Calling AgentRun")
 			err := a.GRPCClient.AgentRun(node, job.ToProto(), ex.ToProto())
 			if err != nil {
 				log.WithFields(logrus.Fields{
 					"job_name": job.Name,
-					"node": node,
+					"node":     node,
 				}).Error("agent: Error calliing AgentRun")
 			}
 		}(v, &wg)
 	}
 	wg.Wait()
 	return job, nil
-}
\ No newline at end of file
+}
+
+// retryNodes selects the node that runs a retry following the job retry placement.
+func (a *Agent) retryNodes(job *Job, ex *Execution) (map[string]string, error) {
+	switch job.RetryPlacement {
+	case RetryPlacementAnyNode, RetryPlacementDifferentNode:
+		var exclude map[string]bool
+		if job.RetryPlacement == RetryPlacementDifferentNode {
+			exclude = a.failedNodes(ex)
+		}
+		nodes, _, err := a.processFilteredNodes(job, exclude)
+		if err != nil {
+			return nil, fmt.Errorf("run error processing filtered nodes: %w", err)
+		}
+		// A retry replaces a single execution
+		for name, addr := range nodes {
+			return map[string]string{name: addr}, nil
+		}
+		return nil, fmt.Errorf("no target nodes left to retry job %s", ex.JobName)
+	default:
+		for _, m := range a.serf.Members() {
+			if ex.NodeName == m.Name {
+				if m.Status == serf.StatusAlive {
+					return map[string]string{ex.NodeName: m.Tags["rpc_addr"]}, nil
+				}
+				break
+			}
+		}
+		return nil, fmt.Errorf("retry node is gone: %s for job %s", ex.NodeName, ex.JobName)
+	}
+}
+
+// failedNodes returns the nodes that failed an execution of the group.
+func (a *Agent) failedNodes(ex *Execution) map[string]bool {
+	failed := map[string]bool{ex.NodeName: true}
+
+	exg, err := a.Store.GetExecutionGroup(ex, &ExecutionOptions{})
+	if err != nil {
+		log.WithError(err).WithField("group", ex.Group).Warn("agent: Error getting execution group, only excluding the last failed node")
+		return failed
+	}
+	for _, e := range exg {
+		if !e.Success && !e.FinishedAt.IsZero() {
+			failed[e.NodeName] = true
+		}
+	}
+	return failed
+}
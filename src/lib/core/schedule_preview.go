@@ -0,0 +1,128 @@
+package core
+
+import (
+	"errors"
+	"strings"
+	"time"
+
+	"spiderjob/lib/extcron"
+)
+
+const (
+	// defaultPreviewCount is the number of fire times returned when no count is given.
+	defaultPreviewCount = 10
+	// maxPreviewCount limits the number of fire times of a preview.
+	maxPreviewCount = 100
+)
+
+var (
+	// ErrWrongPreviewCount is returned when the number of fire times requested is out of range.
+	ErrWrongPreviewCount = errors.New("invalid count, use a value between 1 and 100")
+)
+
+// FireTime is an upcoming run of a schedule.
+type FireTime struct {
+	// Time of the run, in the timezone of the schedule.
+	Time time.Time `json:"time"`
+
+	// Time is in daylight saving time.
+	DST bool `json:"dst"`
+
+	// The UTC offset changed since the previous run, the run
+	// is the first one after a DST transition.
+	DSTTransition bool `json:"dst_transition"`
+}
+
+// SchedulePreview holds the upcoming runs of a schedule expression.
+type SchedulePreview struct {
+	// Schedule expression, as sent to the parser.
+	Schedule string `json:"schedule"`
+
+	// Timezone applied to the expression, empty means the agent local time.
+	Timezone string `json:"timezone"`
+
+	// Calendars excluding days from the schedule.
+	Calendars []string `json:"calendars"`
+
+	// Upcoming runs, empty for schedules that don't run anymore.
+	Next []*FireTime `json:"next"`
+
+	// Days excluded by the calendars that would have runs, up to the last upcoming run.
+	Skipped []string `json:"skipped"`
+}
+
+// withTimezone applies the timezone to cron expressions and monthly
+// weekday descriptors that don't set one.
+func withTimezone(schedule, timezone string) string {
+	descriptor := strings.HasPrefix(schedule, "@") &&
+		!strings.HasPrefix(schedule, extcron.MonthlyFirstWeekday) &&
+		!strings.HasPrefix(schedule, extcron.MonthlyLastWeekday)
+	if timezone != "" &&
+		!descriptor &&
+		!strings.HasPrefix(schedule, "TZ=") &&
+		!strings.HasPrefix(schedule, "CRON_TZ=") {
+		schedule = "CRON_TZ=" + timezone + " " + schedule
+	}
+	return schedule
+}
+
+// PreviewSchedule returns the next count fire times of the schedule after from,
+// parsed the same way the scheduler does with the timezone applied. H tokens
+// are derived from the job name and the days excluded by the calendars are skipped.
+func PreviewSchedule(schedule, timezone, name string, calendars []*Calendar, from time.Time, count int) (*SchedulePreview, error) {
+	if count < 1 || count > maxPreviewCount {
+		return nil, ErrWrongPreviewCount
+	}
+
+	loc := time.Local
+	if timezone != "" {
+		var err error
+		if loc, err = time.LoadLocation(timezone); err != nil {
+			return nil, err
+		}
+	}
+
+	sched, err := parseSchedule(schedule, timezone, name, calendars)
+	if err != nil {
+		return nil, err
+	}
+
+	preview := &SchedulePreview{
+		Schedule:  withTimezone(schedule, timezone),
+		Timezone:  timezone,
+		Calendars: []string{},
+		Next:      []*FireTime{},
+		Skipped:   []string{},
+	}
+	for _, c := range calendars {
+		preview.Calendars = append(preview.Calendars, c.Name)
+	}
+
+	next := sched.Next
+	if ex, ok := sched.(extcron.ExcludeSchedule); ok {
+		next = func(t time.Time) time.Time {
+			return ex.NextSkipping(t, func(s time.Time) {
+				preview.Skipped = append(preview.Skipped, s.Format(extcron.DateLayout))
+			})
+		}
+	}
+
+	_, prevOffset := from.In(loc).Zone()
+	for t := next(from); !t.IsZero(); t = next(t) {
+		t = t.In(loc)
+		_, offset := t.Zone()
+		preview.Next = append(preview.Next, &FireTime{
+			Time:          t,
+			DST:           t.IsDST(),
+			DSTTransition: offset != prevOffset,
+		})
+		prevOffset = offset
+
+		// Stop before looking for more runs, so no days are skipped past the last one
+		if len(preview.Next) == count {
+			break
+		}
+	}
+
+	return preview, nil
+}
@@ -3,8 +3,8 @@ package core
 import (
 	"errors"
 	"expvar"
-	"strings"
 	"sync"
+	"time"
 	"github.com/armon/go-metrics"
 	"spiderjob/lib/extcron"
 	"github.com/robfig/cron/v3"
@@ -17,7 +17,7 @@ var (
 	ErrScheduleParse = errors.New("can't parse job schedule")
 )
 
-type Schedule struct {
+type Scheduler struct {
 	Cron *cron.Cron
 	Started bool
 	EntryJobMap sync.Map
@@ -32,7 +32,7 @@ func NewScheduler() *Scheduler {
 	}
 }
 
-func (s *Schedule) Start(jobs []*Job, agent *Agent) error {
+func (s *Scheduler) Start(jobs []*Job, agent *Agent) error {
 	s.Cron = cron.New(cron.WithParser(extcron.NewParser()))
 
 	metrics.IncrCounter([]string{"scheduler", "start"}, 1)
@@ -79,37 +79,41 @@ func (s *Scheduler) GetEntry(jobName string) (cron.Entry, bool) {
 	return cron.Entry{}, false
 }
 
-func (s *Scheduler) AddJob(Job *Job) error {
+func (s *Scheduler) AddJob(job *Job) error {
+	return s.addJob(job, job.lastFinished())
+}
+
+// addJob adds the job to the cron, the next run of after-completion
+// jobs is anchored at the given time.
+func (s *Scheduler) addJob(job *Job, anchor time.Time) error {
 	if _, ok := s.EntryJobMap.Load(job.Name); ok {
 		s.RemoveJob(job)
 	}
 
-	if job.Disabled || job.ParentJob != "" {
+	if job.Disabled || job.isDependent() {
 		return nil
 	}
 
 	log.WithFields(logrus.Fields{
 		"job": job.Name,
 	}).Debug("scheduler: Adding job to cron")
-	schedule := job.Schedule
-	if job.Timezone != "" && 
-		!strings.HasPrefix(schedule, "@") && 
-		!strings.HasPrefix(schedule, "TZ=") &&
-		!strings.HasPrefix(schedule, "CRON_TZ=") {
-			schedule = "CRON_TZ=" + job.Timezone + " " +schedule
-	}
-	id, err := s.Cron.AddJob(schedule, job)
-	if, err != nil {
+	schedule, err := job.cronSchedule(job.Agent.Store)
+	if err != nil {
 		return err
 	}
+	// Run once, the next run is armed when the execution group finishes
+	if _, ok := afterCompletion(schedule); ok {
+		schedule = job.afterCompletionSchedule(schedule, anchor, time.Now())
+	}
+	id := s.Cron.Schedule(schedule, job)
 	s.EntryJobMap.Store(job.Name, id)
-	cronInspect.Set(job.Name, id)
+	cronInspect.Set(job.Name, job)
 
 	metrics.IncrCounterWithLabels([]string{"scheduler", "job_add"}, 1, []metrics.Label{{Name: "job", Value: job.Name}})
 	return nil
 }
 
-func (s *Scheduler) RemoveJob(Job *Job) {
+func (s *Scheduler) RemoveJob(job *Job) {
 	log.WithFields(logrus.Fields{
 		"job": job.Name,
 	}).Debug("scheduler: Removing job from cron")
@@ -118,6 +122,6 @@ func (s *Scheduler) RemoveJob(Job *Job) {
 		s.EntryJobMap.Delete(job.Name)
 
 		cronInspect.Delete(job.Name)
-		metrics.IncrCounterWithLabels([]string{"scheduler", "job_delete"}, 1, []metrics{{Name: "job", Value: job.Name}})
+		metrics.IncrCounterWithLabels([]string{"scheduler", "job_delete"}, 1, []metrics.Label{{Name: "job", Value: job.Name}})
 	}
 }
\ No newline at end of file
@@ -44,7 +44,7 @@ func (a *Agent) nodeJoin(me serf.MemberEvent) {
 }
 
 func (a *Agent) maybeBootstrap() {
-	var index unint64
+	var index uint64
 	var err error
 	if a.raftStore != nil {
 		index, err =  a.raftStore.LastIndex()
@@ -83,7 +83,7 @@ func (a *Agent) maybeBootstrap() {
 			return 
 		}
 		
-		if p.BootstrapExpect {
+		if p.Bootstrap {
 			log.WithField("member", member).Error("peer has bootstrap mode. Expect disabled")
 			return 
 		}
@@ -103,13 +103,12 @@ func (a *Agent) maybeBootstrap() {
 
 	for _, server := range servers {
 		addr := server.Addr.String()
-		addrs := append(addrs, addr)
+		addrs = append(addrs, addr)
 		id := raft.ServerID(server.ID)
-		suffrange := raft.Voter 
 		peer := raft.Server{
 			ID: id,
-			Address: raft.ServerAddress(addr)
-			Suffrange: suffrange,
+			Address: raft.ServerAddress(addr),
+			Suffrage: raft.Voter,
 		}
 		configuration.Servers = append(configuration.Servers, peer)
 	}
@@ -123,7 +122,7 @@ func (a *Agent) maybeBootstrap() {
 }
 
 func (a *Agent) nodeFailed(me serf.MemberEvent) {
-	for _, m: range me.Members {
+	for _, m := range me.Members {
 		ok, parts := isServer(m) 
 		if !ok {
 			continue
@@ -167,7 +166,7 @@ func (a *Agent) localMemberEvent(me serf.MemberEvent) {
 		}
 		select {
 		case a.reconcileCh <- m:
-		default
+		default:
 		}
 	}
 }
@@ -6,7 +6,10 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"os"
+	"path/filepath"
 	"sort"
+	"strconv"
 	"strings"
 	"sync"
 	"time"
@@ -17,17 +20,21 @@ import (
 )
 
 const (
-	MaxExecutions = 100
 	jobsPrefix = "jobs"
 	executionsPrefix = "executions"
+	retriesPrefix = "retries"
+	calendarsPrefix = "calendars"
+	joinsPrefix = "joins"
+	appliedIndexKey = "raft:applied_index"
+	storeFileName = "store.db"
 )
 
 var (
-	ErrDependtJobs = errors.New("store: could not delete job with dependent jobs, delete childs first")
+	ErrDependentJobs = errors.New("store: could not delete job with dependent jobs, delete childs first")
 )
 
 type Store struct {
-	db *butdb.DB
+	db *buntdb.DB
 	lock *sync.Mutex
 }
 
@@ -50,8 +57,26 @@ type kv struct {
 	Value []byte
 }
 
+// NewStore creates a new in-memory Store, all state is rebuilt from the
+// Raft log and snapshots when the server starts.
 func NewStore() (*Store, error) {
-	db, err := buntdb.Open(":memory:")
+	return openStore(":memory:")
+}
+
+// NewFileStore creates a new Store persisted to a buntdb file under dir,
+// state survives restarts without having to replay the whole Raft history.
+func NewFileStore(dir string) (*Store, error) {
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return nil, fmt.Errorf("store: error creating data dir %s: %s", dir, err)
+	}
+	return openStore(filepath.Join(dir, storeFileName))
+}
+
+func openStore(path string) (*Store, error) {
+	db, err := buntdb.Open(path)
+	if err != nil {
+		return nil, err
+	}
 	db.CreateIndex("name", jobsPrefix + ":*", buntdb.IndexJSON("name"))
 	db.CreateIndex("started_at", executionsPrefix + ":*", buntdb.IndexJSON("started_at"))
 	db.CreateIndex("finished_at", executionsPrefix + ":*", buntdb.IndexJSON("finished_at"))
@@ -63,9 +88,6 @@ func NewStore() (*Store, error) {
 	db.CreateIndex("last_success", executionsPrefix + ":*", buntdb.IndexJSON("last_success"))
 	db.CreateIndex("last_error", executionsPrefix + ":*", buntdb.IndexJSON("last_error"))
 	db.CreateIndex("next", executionsPrefix + ":*", buntdb.IndexJSON("next"))
-	if err != nil {
-		return nil, err
-	}
 
 	store := &Store{
 		db: db,
@@ -74,7 +96,7 @@ func NewStore() (*Store, error) {
 	return store, nil
 }
 
-func (s *Store) setJobTxFunc(pbj *spiderjobpb.job) func(tx *buntdb.Tx) error {
+func (s *Store) setJobTxFunc(pbj *spiderjobpb.Job) func(tx *buntdb.Tx) error {
 	return func(tx *buntdb.Tx) error {
 		jobKey := fmt.Sprintf("%s:%s", jobsPrefix, pbj.Name)
 
@@ -111,6 +133,27 @@ func (s *Store) SetJob(job *Job, copyDependentJobs bool) error {
 		}
 	}
 
+	for _, name := range job.Calendars {
+		if c, _ := s.GetCalendar(name); c == nil {
+			return ErrCalendarNotFound
+		}
+	}
+
+	if len(job.ParentJobs) > 0 {
+		for _, name := range job.ParentJobs {
+			if j, _ := s.GetJob(name, nil); j == nil {
+				return ErrParentJobNotFound
+			}
+		}
+		jobs, err := s.GetJobs(nil)
+		if err != nil {
+			return err
+		}
+		if err := checkDependencyCycle(job, jobs); err != nil {
+			return err
+		}
+	}
+
 	err := s.db.Update(func(tx *buntdb.Tx) error {
 		// Get if the requested job already exist
 		err := s.getJobTxFunc(job.Name, &pbej)(tx)
@@ -248,6 +291,11 @@ func (s *Store) SetExecutionDone(execution *Execution) (bool, error) {
 			return err
 		}
 
+		// Skipped runs don't count as successes or failures
+		if pbe.Skipped {
+			return nil
+		}
+
 		if pbe.Success {
 			pbj.LastSuccess.HasValue = true
 			pbj.LastSuccess.Time = pbe.FinishedAt
@@ -376,8 +424,19 @@ func (s *Store) getJobTxFunc(name string, pbj *spiderjobpb.Job) func(tx *buntdb.
 // DeleteJob deletes the given job from the store, along with
 // all its executions and references to it.
 func (s *Store) DeleteJob(name string) (*Job, error) {
+	// Jobs with parent jobs are not tracked by their parents, look for them
+	jobs, err := s.GetJobs(nil)
+	if err != nil {
+		return nil, err
+	}
+	for _, j := range jobs {
+		if j.hasParent(name) {
+			return nil, ErrDependentJobs
+		}
+	}
+
 	var job *Job
-	err := s.db.Update(func(tx *buntdb.Tx) error {
+	err = s.db.Update(func(tx *buntdb.Tx) error {
 		// Get the job
 		var pbj spiderjobpb.Job
 		if err := s.getJobTxFunc(name, &pbj)(tx); err != nil {
@@ -395,6 +454,14 @@ func (s *Store) DeleteJob(name string) (*Job, error) {
 			return err
 		}
 
+		if err := s.deletePendingRetriesTxFunc(name)(tx); err != nil {
+			return err
+		}
+
+		if _, err := tx.Delete(fmt.Sprintf("%s:%s", joinsPrefix, name)); err != nil && err != buntdb.ErrNotFound {
+			return err
+		}
+
 		_, err := tx.Delete(fmt.Sprintf("%s:%s", jobsPrefix, name))
 		return err
 	})
@@ -552,39 +619,271 @@ func (s *Store) SetExecution(execution *Execution) (string, error) {
 		return "", err
 	}
 
-	execs, err := s.GetExecutions(execution.JobName, &ExecutionOptions{})
-	if err != nil && err != buntdb.ErrNotFound {
-		log.WithError(err).
-			WithField("job", execution.JobName).
-			Error("store: Error getting executions for job")
+	return key, nil
+}
+
+// DeleteExecutions removes the given executions of a job.
+func (s *Store) DeleteExecutions(jobName string, ids []string) error {
+	return s.db.Update(func(tx *buntdb.Tx) error {
+		for _, id := range ids {
+			k := fmt.Sprintf("%s:%s:%s", executionsPrefix, jobName, id)
+			if _, err := tx.Delete(k); err != nil && err != buntdb.ErrNotFound {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// SetPendingRetry saves a retry scheduled by the leader.
+func (s *Store) SetPendingRetry(retry *PendingRetry) error {
+	key := fmt.Sprintf("%s:%s:%s", retriesPrefix, retry.Execution.JobName, retry.Key())
+
+	rb, err := json.Marshal(retry.ToProto())
+	if err != nil {
+		return err
+	}
+
+	return s.db.Update(func(tx *buntdb.Tx) error {
+		_, _, err := tx.Set(key, string(rb), nil)
+		return err
+	})
+}
+
+// DeletePendingRetry removes a retry once it runs.
+func (s *Store) DeletePendingRetry(jobName, executionID string) error {
+	key := fmt.Sprintf("%s:%s:%s", retriesPrefix, jobName, executionID)
+
+	return s.db.Update(func(tx *buntdb.Tx) error {
+		if _, err := tx.Delete(key); err != nil && err != buntdb.ErrNotFound {
+			return err
+		}
+		return nil
+	})
+}
+
+// GetPendingRetries returns all the retries scheduled by the leader.
+func (s *Store) GetPendingRetries() ([]*PendingRetry, error) {
+	var retries []*PendingRetry
+
+	err := s.db.View(func(tx *buntdb.Tx) error {
+		var err error
+		tx.AscendKeys(retriesPrefix+":*", func(key, value string) bool {
+			var pr spiderjobpb.PendingRetry
+			if err = json.Unmarshal([]byte(value), &pr); err != nil {
+				return false
+			}
+			retries = append(retries, NewPendingRetryFromProto(&pr))
+			return true
+		})
+		return err
+	})
+
+	return retries, err
+}
+
+// SetCalendar saves a calendar.
+func (s *Store) SetCalendar(calendar *Calendar) error {
+	if err := calendar.Validate(); err != nil {
+		return err
+	}
+
+	key := fmt.Sprintf("%s:%s", calendarsPrefix, calendar.Name)
+	cb, err := json.Marshal(calendar.ToProto())
+	if err != nil {
+		return err
+	}
+
+	return s.db.Update(func(tx *buntdb.Tx) error {
+		_, _, err := tx.Set(key, string(cb), nil)
+		return err
+	})
+}
+
+// DeleteCalendar removes a calendar not used by any job.
+func (s *Store) DeleteCalendar(name string) (*Calendar, error) {
+	calendar, err := s.GetCalendar(name)
+	if err != nil {
+		return nil, err
+	}
+
+	jobs, err := s.GetJobs(nil)
+	if err != nil {
+		return nil, err
+	}
+	for _, job := range jobs {
+		for _, c := range job.Calendars {
+			if c == name {
+				return nil, ErrCalendarInUse
+			}
+		}
+	}
+
+	key := fmt.Sprintf("%s:%s", calendarsPrefix, name)
+	err = s.db.Update(func(tx *buntdb.Tx) error {
+		_, err := tx.Delete(key)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return calendar, nil
+}
+
+// GetCalendar returns a calendar by name.
+func (s *Store) GetCalendar(name string) (*Calendar, error) {
+	var pc spiderjobpb.Calendar
+
+	key := fmt.Sprintf("%s:%s", calendarsPrefix, name)
+	err := s.db.View(func(tx *buntdb.Tx) error {
+		value, err := tx.Get(key)
+		if err != nil {
+			return err
+		}
+		return json.Unmarshal([]byte(value), &pc)
+	})
+	if err != nil {
+		return nil, err
 	}
 
-	// Delete all execution results over the limit, starting from olders
-	if len(execs) > MaxExecutions {
-		//sort the array of all execution groups by StartedAt time
-		sort.Slice(execs, func(i, j int) bool {
-			return execs[i].StartedAt.Before(execs[j].StartedAt)
+	return NewCalendarFromProto(&pc), nil
+}
+
+// GetCalendars returns all the calendars.
+func (s *Store) GetCalendars() ([]*Calendar, error) {
+	calendars := []*Calendar{}
+
+	err := s.db.View(func(tx *buntdb.Tx) error {
+		var err error
+		tx.AscendKeys(calendarsPrefix+":*", func(key, value string) bool {
+			var pc spiderjobpb.Calendar
+			if err = json.Unmarshal([]byte(value), &pc); err != nil {
+				return false
+			}
+			calendars = append(calendars, NewCalendarFromProto(&pc))
+			return true
 		})
+		return err
+	})
+
+	return calendars, err
+}
+
+// JoinParentRun records the finished run of the parent in the join state of the job,
+// in a single transaction so parents finishing at the same time don't overwrite
+// each other. The state is reset to wait for the next parent runs only when the
+// join is ready and the job can run, otherwise the join stays ready.
+func (s *Store) JoinParentRun(jobName, parent string, run *UpstreamRun, runnable bool) (*JoinResult, error) {
+	res := &JoinResult{}
+	key := fmt.Sprintf("%s:%s", joinsPrefix, jobName)
+
+	err := s.db.Update(func(tx *buntdb.Tx) error {
+		var pbj spiderjobpb.Job
+		if err := s.getJobTxFunc(jobName, &pbj)(tx); err != nil {
+			return err
+		}
+		job := NewJobFromProto(&pbj)
 
-		for i := 0; i < len(execs)-MaxExecutions; i++ {
-			log.WithFields(logrus.Fields{
-				"job":       execs[i].JobName,
-				"execution": execs[i].Key(),
-			}).Debug("store: to detele key")
-			err = s.db.Update(func(tx *buntdb.Tx) error {
-				k := fmt.Sprintf("%s:%s:%s", executionsPrefix, execs[i].JobName, execs[i].Key())
-				_, err := tx.Delete(k)
+		pjs := spiderjobpb.JoinState{JobName: jobName}
+		value, err := tx.Get(key)
+		if err != nil && err != buntdb.ErrNotFound {
+			return err
+		}
+		if err == nil {
+			if err := json.Unmarshal([]byte(value), &pjs); err != nil {
 				return err
-			})
-			if err != nil {
-				log.WithError(err).
-					WithField("execution", execs[i].Key()).
-					Error("store: Error trying to delete overflowed execution")
 			}
 		}
+		state := NewJoinStateFromProto(&pjs)
+
+		// Several executions of the parent report the same run
+		if state.LastGroups[parent] == run.Group {
+			return nil
+		}
+		state.Parents[parent] = run
+		state.LastGroups[parent] = run.Group
+
+		res.Ready = job.joinReady(state)
+		if res.Ready {
+			res.Outputs = joinOutputs(job, state)
+			if runnable {
+				// Start waiting for the next runs of the parents
+				state.Parents = make(map[string]*UpstreamRun)
+			}
+		}
+
+		jb, err := json.Marshal(state.ToProto())
+		if err != nil {
+			return err
+		}
+		_, _, err = tx.Set(key, string(jb), nil)
+		return err
+	})
+	if err != nil {
+		return nil, err
 	}
+	return res, nil
+}
 
-	return key, nil
+// TriggerDependentRun records the run of the parent triggering a dependent job
+// in its join state, it returns false if the run already triggered the job,
+// reported by several executions of the parent or by a retried ExecutionDone.
+func (s *Store) TriggerDependentRun(jobName, parent string, group int64) (bool, error) {
+	triggered := false
+	key := fmt.Sprintf("%s:%s", joinsPrefix, jobName)
+
+	err := s.db.Update(func(tx *buntdb.Tx) error {
+		pjs := spiderjobpb.JoinState{JobName: jobName}
+		value, err := tx.Get(key)
+		if err != nil && err != buntdb.ErrNotFound {
+			return err
+		}
+		if err == nil {
+			if err := json.Unmarshal([]byte(value), &pjs); err != nil {
+				return err
+			}
+		}
+		state := NewJoinStateFromProto(&pjs)
+
+		if state.LastGroups[parent] == group {
+			return nil
+		}
+		state.LastGroups[parent] = group
+		triggered = true
+
+		jb, err := json.Marshal(state.ToProto())
+		if err != nil {
+			return err
+		}
+		_, _, err = tx.Set(key, string(jb), nil)
+		return err
+	})
+	if err != nil {
+		return false, err
+	}
+	return triggered, nil
+}
+
+// GetJoinState returns the parent runs a job is waiting for,
+// an empty state if no parent ran yet.
+func (s *Store) GetJoinState(jobName string) (*JoinState, error) {
+	var pjs spiderjobpb.JoinState
+
+	key := fmt.Sprintf("%s:%s", joinsPrefix, jobName)
+	err := s.db.View(func(tx *buntdb.Tx) error {
+		value, err := tx.Get(key)
+		if err != nil {
+			return err
+		}
+		return json.Unmarshal([]byte(value), &pjs)
+	})
+	if err == buntdb.ErrNotFound {
+		pjs = spiderjobpb.JoinState{JobName: jobName}
+	} else if err != nil {
+		return nil, err
+	}
+
+	return NewJoinStateFromProto(&pjs), nil
 }
 
 // DeleteExecutions removes all executions of a job
@@ -607,6 +906,52 @@ func (s *Store) deleteExecutionsTxFunc(jobName string) func(tx *buntdb.Tx) error
 	}
 }
 
+// deletePendingRetriesTxFunc removes the retries scheduled for the job.
+func (s *Store) deletePendingRetriesTxFunc(jobName string) func(tx *buntdb.Tx) error {
+	return func(tx *buntdb.Tx) error {
+		var delkeys []string
+		prefix := fmt.Sprintf("%s:%s:", retriesPrefix, jobName)
+		tx.AscendKeys(prefix+"*", func(key, value string) bool {
+			delkeys = append(delkeys, key)
+			return true
+		})
+
+		for _, k := range delkeys {
+			if _, err := tx.Delete(k); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}
+}
+
+// SetAppliedIndex saves the index of the last Raft log entry applied to the store.
+func (s *Store) SetAppliedIndex(index uint64) error {
+	return s.db.Update(func(tx *buntdb.Tx) error {
+		_, _, err := tx.Set(appliedIndexKey, strconv.FormatUint(index, 10), nil)
+		return err
+	})
+}
+
+// GetAppliedIndex returns the index of the last Raft log entry applied
+// to the store, 0 if none was.
+func (s *Store) GetAppliedIndex() (uint64, error) {
+	var index uint64
+	err := s.db.View(func(tx *buntdb.Tx) error {
+		v, err := tx.Get(appliedIndexKey)
+		if err == buntdb.ErrNotFound {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		index, err = strconv.ParseUint(v, 10, 64)
+		return err
+	})
+	return index, err
+}
+
 // Shutdown close the KV store
 func (s *Store) Shutdown() error {
 	return s.db.Close()
@@ -617,9 +962,33 @@ func (s *Store) Snapshot(w io.WriteCloser) error {
 	return s.db.Save(w)
 }
 
-// Restore load data created with backup in to Bunt
+// Restore load data created with backup in to Bunt, replacing the current
+// content so a persistent store doesn't keep keys missing from the snapshot.
+// Bunt can't load into a persistent db, so the snapshot is loaded in memory
+// and copied in a single transaction.
 func (s *Store) Restore(r io.ReadCloser) error {
-	return s.db.Load(r)
+	snap, err := buntdb.Open(":memory:")
+	if err != nil {
+		return err
+	}
+	defer snap.Close()
+	if err := snap.Load(r); err != nil {
+		return err
+	}
+
+	return s.db.Update(func(tx *buntdb.Tx) error {
+		if err := tx.DeleteAll(); err != nil {
+			return err
+		}
+		return snap.View(func(stx *buntdb.Tx) error {
+			var err error
+			stx.Ascend("", func(key, value string) bool {
+				_, _, err = tx.Set(key, value, nil)
+				return err == nil
+			})
+			return err
+		})
+	})
 }
 
 func (s *Store) unmarshalExecutions(items []kv, timezone *time.Location) ([]*Execution, error) {
@@ -661,7 +1030,7 @@ func (s *Store) computeStatus(jobName string, exGroup int64, tx *buntdb.Tx) (str
 	}
 
 	var executions []*Execution
-	for _, ex := range execs {
+	for _, ex := range ranExecutions(execs) {
 		if ex.Group == exGroup {
 			executions = append(executions, ex)
 		}
@@ -0,0 +1,456 @@
+package core
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/tidwall/buntdb"
+)
+
+// storageBackends opens an empty store of every backend.
+var storageBackends = []struct {
+	name string
+	open func(t *testing.T) Storage
+}{
+	{
+		name: StorageBackendMemory,
+		open: func(t *testing.T) Storage {
+			s, err := NewStore()
+			if err != nil {
+				t.Fatal(err)
+			}
+			return s
+		},
+	},
+	{
+		name: StorageBackendDisk,
+		open: func(t *testing.T) Storage {
+			dir, err := ioutil.TempDir("", "spiderjob-store")
+			if err != nil {
+				t.Fatal(err)
+			}
+			t.Cleanup(func() { os.RemoveAll(dir) })
+			s, err := NewFileStore(dir)
+			if err != nil {
+				t.Fatal(err)
+			}
+			return s
+		},
+	},
+}
+
+func testJob(name string) *Job {
+	return &Job{
+		Name:     name,
+		Schedule: "@every 1m",
+		Executor: "shell",
+		ExecutorConfig: map[string]string{
+			"command": "echo " + name,
+		},
+	}
+}
+
+func testExecution(jobName string, startedAt time.Time, success bool) *Execution {
+	ex := NewExecution(jobName)
+	ex.NodeName = "node1"
+	ex.StartedAt = startedAt
+	ex.FinishedAt = startedAt.Add(time.Second)
+	ex.Success = success
+	return ex
+}
+
+func TestStorage(t *testing.T) {
+	start := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name string
+		run  func(t *testing.T, s Storage)
+	}{
+		{
+			name: "set and get job",
+			run: func(t *testing.T, s Storage) {
+				if err := s.SetJob(testJob("job1"), false); err != nil {
+					t.Fatal(err)
+				}
+				j, err := s.GetJob("job1", nil)
+				if err != nil {
+					t.Fatal(err)
+				}
+				if j.Name != "job1" || j.Schedule != "@every 1m" || j.ExecutorConfig["command"] != "echo job1" {
+					t.Fatalf("got job %+v", j)
+				}
+				if _, err := s.GetJob("missing", nil); err != buntdb.ErrNotFound {
+					t.Fatalf("got error %v, want %v", err, buntdb.ErrNotFound)
+				}
+			},
+		},
+		{
+			name: "update job keeps status",
+			run: func(t *testing.T, s Storage) {
+				if err := s.SetJob(testJob("job1"), false); err != nil {
+					t.Fatal(err)
+				}
+				if _, err := s.SetExecutionDone(testExecution("job1", start, true)); err != nil {
+					t.Fatal(err)
+				}
+				j := testJob("job1")
+				j.Schedule = "@every 2m"
+				if err := s.SetJob(j, false); err != nil {
+					t.Fatal(err)
+				}
+				j, err := s.GetJob("job1", nil)
+				if err != nil {
+					t.Fatal(err)
+				}
+				if j.Schedule != "@every 2m" || j.SuccessCount != 1 || j.Status != StatusSuccess {
+					t.Fatalf("got job %+v", j)
+				}
+			},
+		},
+		{
+			name: "get jobs",
+			run: func(t *testing.T, s Storage) {
+				for _, name := range []string{"job2", "job1"} {
+					if err := s.SetJob(testJob(name), false); err != nil {
+						t.Fatal(err)
+					}
+				}
+				jobs, err := s.GetJobs(nil)
+				if err != nil {
+					t.Fatal(err)
+				}
+				if len(jobs) != 2 || jobs[0].Name != "job1" || jobs[1].Name != "job2" {
+					t.Fatalf("got %d jobs", len(jobs))
+				}
+			},
+		},
+		{
+			name: "delete job",
+			run: func(t *testing.T, s Storage) {
+				if err := s.SetJob(testJob("job1"), false); err != nil {
+					t.Fatal(err)
+				}
+				if _, err := s.SetExecution(testExecution("job1", start, true)); err != nil {
+					t.Fatal(err)
+				}
+				retry := &PendingRetry{Execution: testExecution("job1", start, false), RunAt: start}
+				if err := s.SetPendingRetry(retry); err != nil {
+					t.Fatal(err)
+				}
+				j, err := s.DeleteJob("job1")
+				if err != nil {
+					t.Fatal(err)
+				}
+				if j.Name != "job1" {
+					t.Fatalf("deleted job %q", j.Name)
+				}
+				if _, err := s.GetJob("job1", nil); err != buntdb.ErrNotFound {
+					t.Fatalf("got error %v, want %v", err, buntdb.ErrNotFound)
+				}
+				exs, err := s.GetExecutions("job1", &ExecutionOptions{})
+				if err != nil && err != buntdb.ErrNotFound {
+					t.Fatal(err)
+				}
+				if len(exs) != 0 {
+					t.Fatalf("got %d executions of the deleted job", len(exs))
+				}
+				retries, err := s.GetPendingRetries()
+				if err != nil {
+					t.Fatal(err)
+				}
+				if len(retries) != 0 {
+					t.Fatalf("got %d pending retries of the deleted job", len(retries))
+				}
+			},
+		},
+		{
+			name: "delete job with dependent jobs",
+			run: func(t *testing.T, s Storage) {
+				if err := s.SetJob(testJob("parent"), false); err != nil {
+					t.Fatal(err)
+				}
+				child := testJob("child")
+				child.Schedule = ""
+				child.ParentJob = "parent"
+				if err := s.SetJob(child, false); err != nil {
+					t.Fatal(err)
+				}
+				if _, err := s.DeleteJob("parent"); err != ErrDependentJobs {
+					t.Fatalf("got error %v, want %v", err, ErrDependentJobs)
+				}
+			},
+		},
+		{
+			name: "executions",
+			run: func(t *testing.T, s Storage) {
+				if err := s.SetJob(testJob("job1"), false); err != nil {
+					t.Fatal(err)
+				}
+				first := testExecution("job1", start, true)
+				second := testExecution("job1", start.Add(time.Minute), false)
+				for _, ex := range []*Execution{first, second} {
+					if _, err := s.SetExecutionDone(ex); err != nil {
+						t.Fatal(err)
+					}
+				}
+
+				exs, err := s.GetExecutions("job1", &ExecutionOptions{})
+				if err != nil {
+					t.Fatal(err)
+				}
+				if len(exs) != 2 {
+					t.Fatalf("got %d executions, want 2", len(exs))
+				}
+
+				j, err := s.GetJob("job1", nil)
+				if err != nil {
+					t.Fatal(err)
+				}
+				if j.SuccessCount != 1 || j.ErrorCount != 1 || j.Status != StatusFailed {
+					t.Fatalf("got job %+v", j)
+				}
+
+				if err := s.DeleteExecutions("job1", []string{first.Key()}); err != nil {
+					t.Fatal(err)
+				}
+				exs, err = s.GetExecutions("job1", &ExecutionOptions{})
+				if err != nil {
+					t.Fatal(err)
+				}
+				if len(exs) != 1 || exs[0].Key() != second.Key() {
+					t.Fatalf("got %d executions after delete", len(exs))
+				}
+			},
+		},
+		{
+			name: "skipped executions don't count",
+			run: func(t *testing.T, s Storage) {
+				if err := s.SetJob(testJob("job1"), false); err != nil {
+					t.Fatal(err)
+				}
+				if _, err := s.SetExecutionDone(testExecution("job1", start, true)); err != nil {
+					t.Fatal(err)
+				}
+				skipped := testExecution("job1", start.Add(time.Minute), false)
+				skipped.Skipped = true
+				skipped.SkipReason = SkipOutsideWindow
+				if _, err := s.SetExecutionDone(skipped); err != nil {
+					t.Fatal(err)
+				}
+
+				j, err := s.GetJob("job1", nil)
+				if err != nil {
+					t.Fatal(err)
+				}
+				if j.ErrorCount != 0 || j.LastError.HasValue() || j.Status != StatusSuccess {
+					t.Fatalf("got job %+v", j)
+				}
+				exs, err := s.GetExecutions("job1", &ExecutionOptions{})
+				if err != nil {
+					t.Fatal(err)
+				}
+				if len(exs) != 2 {
+					t.Fatalf("got %d executions, want 2", len(exs))
+				}
+			},
+		},
+		{
+			name: "execution group",
+			run: func(t *testing.T, s Storage) {
+				if err := s.SetJob(testJob("job1"), false); err != nil {
+					t.Fatal(err)
+				}
+				ex1 := testExecution("job1", start, true)
+				ex2 := testExecution("job1", start, true)
+				ex2.NodeName = "node2"
+				ex2.Group = ex1.Group
+				for _, ex := range []*Execution{ex1, ex2} {
+					if _, err := s.SetExecutionDone(ex); err != nil {
+						t.Fatal(err)
+					}
+				}
+				group, err := s.GetExecutionGroup(ex1, &ExecutionOptions{})
+				if err != nil {
+					t.Fatal(err)
+				}
+				if len(group) != 2 {
+					t.Fatalf("got %d executions in the group, want 2", len(group))
+				}
+			},
+		},
+		{
+			name: "execution done for a deleted job",
+			run: func(t *testing.T, s Storage) {
+				if _, err := s.SetExecutionDone(testExecution("missing", start, true)); err != ErrExecutionDoneForDeletedJob {
+					t.Fatalf("got error %v, want %v", err, ErrExecutionDoneForDeletedJob)
+				}
+			},
+		},
+		{
+			name: "join parent runs",
+			run: func(t *testing.T, s Storage) {
+				for _, name := range []string{"parent1", "parent2"} {
+					if err := s.SetJob(testJob(name), false); err != nil {
+						t.Fatal(err)
+					}
+				}
+				child := testJob("child")
+				child.Schedule = ""
+				child.ParentJobs = []string{"parent1", "parent2"}
+				if err := s.SetJob(child, false); err != nil {
+					t.Fatal(err)
+				}
+
+				join := func(parent string, group int64, runnable bool, want bool) {
+					t.Helper()
+					run := &UpstreamRun{Group: group, Success: true, FinishedAt: start}
+					res, err := s.JoinParentRun("child", parent, run, runnable)
+					if err != nil {
+						t.Fatal(err)
+					}
+					if res.Ready != want {
+						t.Fatalf("%s run %d: got ready %t, want %t", parent, group, res.Ready, want)
+					}
+				}
+				join("parent1", 1, true, false)
+				// Not runnable, the join stays ready
+				join("parent2", 1, false, true)
+				// The same run reported by another execution is ignored
+				join("parent2", 1, true, false)
+				join("parent2", 2, true, true)
+
+				state, err := s.GetJoinState("child")
+				if err != nil {
+					t.Fatal(err)
+				}
+				if len(state.Parents) != 0 || state.LastGroups["parent2"] != 2 {
+					t.Fatalf("got join state %+v", state)
+				}
+			},
+		},
+		{
+			name: "trigger dependent run",
+			run: func(t *testing.T, s Storage) {
+				if err := s.SetJob(testJob("parent"), false); err != nil {
+					t.Fatal(err)
+				}
+				child := testJob("child")
+				child.Schedule = ""
+				child.ParentJob = "parent"
+				if err := s.SetJob(child, false); err != nil {
+					t.Fatal(err)
+				}
+
+				trigger := func(group int64, want bool) {
+					t.Helper()
+					triggered, err := s.TriggerDependentRun("child", "parent", group)
+					if err != nil {
+						t.Fatal(err)
+					}
+					if triggered != want {
+						t.Fatalf("run %d: got triggered %t, want %t", group, triggered, want)
+					}
+				}
+				trigger(1, true)
+				// The same run reported by another execution is ignored
+				trigger(1, false)
+				trigger(2, true)
+
+				state, err := s.GetJoinState("child")
+				if err != nil {
+					t.Fatal(err)
+				}
+				if state.LastGroups["parent"] != 2 {
+					t.Fatalf("got join state %+v", state)
+				}
+			},
+		},
+		{
+			name: "snapshot and restore",
+			run: func(t *testing.T, s Storage) {
+				if err := s.SetJob(testJob("job1"), false); err != nil {
+					t.Fatal(err)
+				}
+				if _, err := s.SetExecutionDone(testExecution("job1", start, true)); err != nil {
+					t.Fatal(err)
+				}
+
+				var buf bytes.Buffer
+				if err := s.Snapshot(nopCloser{&buf}); err != nil {
+					t.Fatal(err)
+				}
+
+				// Keys missing from the snapshot are removed on restore
+				if err := s.SetJob(testJob("job2"), false); err != nil {
+					t.Fatal(err)
+				}
+				if err := s.Restore(ioutil.NopCloser(&buf)); err != nil {
+					t.Fatal(err)
+				}
+
+				jobs, err := s.GetJobs(nil)
+				if err != nil {
+					t.Fatal(err)
+				}
+				if len(jobs) != 1 || jobs[0].Name != "job1" || jobs[0].SuccessCount != 1 {
+					t.Fatalf("got %d jobs after restore", len(jobs))
+				}
+				exs, err := s.GetExecutions("job1", &ExecutionOptions{})
+				if err != nil {
+					t.Fatal(err)
+				}
+				if len(exs) != 1 {
+					t.Fatalf("got %d executions after restore, want 1", len(exs))
+				}
+			},
+		},
+	}
+
+	for _, b := range storageBackends {
+		for _, tt := range tests {
+			t.Run(b.name+"/"+tt.name, func(t *testing.T) {
+				s := b.open(t)
+				defer s.Shutdown()
+				tt.run(t, s)
+			})
+		}
+	}
+}
+
+// TestFileStoreReopen checks the disk backend keeps the state across restarts.
+func TestFileStoreReopen(t *testing.T) {
+	dir, err := ioutil.TempDir("", "spiderjob-store")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	s, err := NewFileStore(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := s.SetJob(testJob("job1"), false); err != nil {
+		t.Fatal(err)
+	}
+	if err := s.Shutdown(); err != nil {
+		t.Fatal(err)
+	}
+
+	s, err = NewFileStore(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer s.Shutdown()
+	if _, err := s.GetJob("job1", nil); err != nil {
+		t.Fatalf("job not found after reopening the store: %s", err)
+	}
+}
+
+type nopCloser struct {
+	*bytes.Buffer
+}
+
+func (nopCloser) Close() error { return nil }
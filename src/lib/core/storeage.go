@@ -4,14 +4,33 @@ import (
 	"io"
 )
 
-type Storeage interface {
+// Storage is the interface that should be used by any
+// storage engine implemented for spiderjob. It contains the
+// minimum set of operations that are needed to have a working
+// spiderjob store.
+type Storage interface {
 	SetJob(job *Job, copyDependentJobs bool) error
 	DeleteJob(name string) (*Job, error)
-	SetExecution(execution *Execution) (bool, error)
+	SetExecution(execution *Execution) (string, error)
+	SetExecutionDone(execution *Execution) (bool, error)
+	DeleteExecutions(jobName string, ids []string) error
+	SetPendingRetry(retry *PendingRetry) error
+	DeletePendingRetry(jobName, executionID string) error
+	GetPendingRetries() ([]*PendingRetry, error)
+	SetCalendar(calendar *Calendar) error
+	DeleteCalendar(name string) (*Calendar, error)
+	GetCalendar(name string) (*Calendar, error)
+	GetCalendars() ([]*Calendar, error)
+	JoinParentRun(jobName, parent string, run *UpstreamRun, runnable bool) (*JoinResult, error)
+	TriggerDependentRun(jobName, parent string, group int64) (bool, error)
+	GetJoinState(jobName string) (*JoinState, error)
 	GetJobs(options *JobOptions) ([]*Job, error)
-	GetExecutions(jobName string, opts *ExecutionOptions) ([]*ExecutionOption, error)
+	GetJob(name string, options *JobOptions) (*Job, error)
+	GetExecutions(jobName string, opts *ExecutionOptions) ([]*Execution, error)
 	GetExecutionGroup(execution *Execution, opts *ExecutionOptions) ([]*Execution, error)
 	GetGroupedExecutions(jobName string, opts *ExecutionOptions) (map[int64][]*Execution, []int64, error)
+	SetAppliedIndex(index uint64) error
+	GetAppliedIndex() (uint64, error)
 	Shutdown() error
 	Snapshot(w io.WriteCloser) error
 	Restore(r io.ReadCloser) error
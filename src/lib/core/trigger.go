@@ -0,0 +1,126 @@
+package core
+
+import (
+	"errors"
+	"fmt"
+
+	proto "spiderjob/lib/plugin/types"
+
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	// TriggerOnSuccess runs the job when every execution of the parent run succeeded, the default.
+	TriggerOnSuccess = "on_success"
+	// TriggerOnFailure runs the job when every execution of the parent run failed.
+	TriggerOnFailure = "on_failure"
+	// TriggerOnPartialFailure runs the job when some executions of the parent run failed and others succeeded.
+	TriggerOnPartialFailure = "on_partial_failure"
+	// TriggerOnAlways runs the job when the parent run finished, whatever its result.
+	TriggerOnAlways = "always"
+)
+
+var (
+	// ErrWrongTriggerOn is returned when the edge condition of a job is not valid.
+	ErrWrongTriggerOn = errors.New("invalid trigger_on value, use \"on_success\", \"on_failure\", \"on_partial_failure\" or \"always\"")
+	// ErrTriggerOnWithoutParent is returned when a job sets an edge condition without a parent job.
+	ErrTriggerOnWithoutParent = errors.New("trigger_on can only be set on jobs with a parent job")
+)
+
+// triggerEdge returns the edge condition of the job, defaulting to on_success.
+func (j *Job) triggerEdge() string {
+	if j.TriggerOn == "" {
+		return TriggerOnSuccess
+	}
+	return j.TriggerOn
+}
+
+// triggeredBy returns if a finished parent run with the given status meets
+// the edge condition of the job.
+func (j *Job) triggeredBy(status string) bool {
+	switch j.triggerEdge() {
+	case TriggerOnAlways:
+		return true
+	case TriggerOnFailure:
+		return status == StatusFailed
+	case TriggerOnPartialFailure:
+		return status == StatusPartialyFailed
+	default:
+		return status == StatusSuccess
+	}
+}
+
+// runDependentJobs runs the dependent jobs of the parent whose edge condition
+// is met by the finished run, recording the edge on the triggered execution.
+// Every run of the parent triggers a dependent job once, even if it's reported
+// by several executions. This only works on the leader.
+func (a *Agent) runDependentJobs(parent *Job, group []*Execution) error {
+	group = ranExecutions(group)
+	if finished, _ := groupFinished(group); !finished {
+		return nil
+	}
+
+	for _, djn := range parent.DependentJobs {
+		dj, err := a.Store.GetJob(djn, nil)
+		if err != nil {
+			return err
+		}
+		dj.Agent = a
+		if !dj.triggeredBy(parent.Status) {
+			continue
+		}
+		triggered, err := a.triggerDependentRun(dj.Name, parent.Name, group[0].Group)
+		if err != nil {
+			return err
+		}
+		if !triggered {
+			continue
+		}
+		if runnable, reason := dj.isRunnable(); !runnable {
+			if reason != "" {
+				a.recordSkipped(dj, reason)
+			}
+			continue
+		}
+
+		log.WithFields(logrus.Fields{
+			"job":    djn,
+			"parent": parent.Name,
+			"edge":   dj.triggerEdge(),
+		}).Debug("agent: Running dependent job")
+
+		ex := NewExecution(dj.Name)
+		ex.TriggeredBy = parent.Name
+		ex.TriggerEdge = dj.triggerEdge()
+		ex.WorkflowRun = workflowRunID(group)
+		ex.ParentOutputs = groupResults(group)
+		dj.RunExecution(ex)
+	}
+	return nil
+}
+
+// triggerDependentRun records the parent run triggering a dependent job through
+// the FSM, it returns false if the run already triggered the job.
+func (a *Agent) triggerDependentRun(jobName, parent string, group int64) (bool, error) {
+	cmd, err := Encode(TriggerDependentRunType, &proto.JoinParentRunRequest{
+		JobName: jobName,
+		Parent:  parent,
+		Run:     &proto.UpstreamRun{Group: group},
+	})
+	if err != nil {
+		return false, err
+	}
+	af := a.raft.Apply(cmd, raftTimeout)
+	if err := af.Error(); err != nil {
+		return false, err
+	}
+	res := af.Response()
+	if err, ok := res.(error); ok {
+		return false, err
+	}
+	triggered, ok := res.(bool)
+	if !ok {
+		return false, fmt.Errorf("agent: Error wrong response from apply in triggerDependentRun: %v", res)
+	}
+	return triggered, nil
+}
@@ -1 +1,26 @@
-package core
\ No newline at end of file
+package core
+
+import (
+	"net/http"
+
+	"spiderjob/lib/core/assets_ui"
+
+	"github.com/gin-gonic/gin"
+)
+
+const uiPathPrefix = "ui"
+
+// UI registers the routes of the web UI on the gin RouterGroup.
+func (h *HTTPTransport) UI(r *gin.RouterGroup) {
+	// If we are visiting from a browser redirect to the UI
+	r.GET("/", func(c *gin.Context) {
+		switch c.NegotiateFormat(gin.MIMEHTML) {
+		case gin.MIMEHTML:
+			c.Redirect(http.StatusMovedPermanently, "/"+uiPathPrefix+"/")
+		default:
+			c.AbortWithStatus(http.StatusNotFound)
+		}
+	})
+
+	r.StaticFS(uiPathPrefix, assets_ui.Assets)
+}
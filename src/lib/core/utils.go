@@ -25,8 +25,8 @@ type ServerParts struct {
 	Datacenter string
 	Port int
 	Bootstrap bool
-	Expect bool
-	RaftVersion *version.Version
+	Expect int
+	BuildVersion *version.Version
 	Addr net.Addr
 	RPCAddr net.Addr
 	Status serf.MemberStatus
@@ -88,7 +88,7 @@ func isServer(m serf.Member) (bool, *ServerParts) {
 
 	buildVersion, err := version.NewVersion(m.Tags["version"])
 	if err != nil {
-		buildVersion = &version.Version[]
+		buildVersion = &version.Version{}
 	}
 
 	addr := &net.TCPAddr{IP: m.Addr, Port: port}
@@ -104,7 +104,7 @@ func isServer(m serf.Member) (bool, *ServerParts) {
 		Addr: addr,
 		RPCAddr: rpcAddr,
 		BuildVersion: buildVersion,
-		Status: m.status
+		Status: m.Status,
 	}
 	return true, parts
 }
\ No newline at end of file
@@ -0,0 +1,161 @@
+package core
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	proto "spiderjob/lib/plugin/types"
+
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	// SkipOutsideWindow is the skip reason of runs outside the allowed windows of the job.
+	SkipOutsideWindow = "outside-allowed-window"
+)
+
+var (
+	// ErrWrongWindow is returned when an allowed window has invalid values.
+	ErrWrongWindow = errors.New("invalid allowed window, use days like \"mon\" and times like \"22:00\"")
+	// ErrOutsideWindow is returned when running a job outside its allowed windows without forcing it.
+	ErrOutsideWindow = errors.New("job is outside its allowed windows, force the run to override")
+)
+
+var windowDays = map[string]time.Weekday{
+	"sun": time.Sunday,
+	"mon": time.Monday,
+	"tue": time.Tuesday,
+	"wed": time.Wednesday,
+	"thu": time.Thursday,
+	"fri": time.Friday,
+	"sat": time.Saturday,
+}
+
+// TimeWindow is a time of day range a job is allowed to start in.
+type TimeWindow struct {
+	// Days of the week the window opens, like "mon". Empty means every day.
+	Days []string `json:"days"`
+
+	// Time the window opens, like "22:00".
+	Start string `json:"start"`
+
+	// Time the window closes, like "06:00". Windows ending before they start close
+	// the next day, windows ending when they start last the whole day.
+	End string `json:"end"`
+}
+
+// NewTimeWindowFromProto maps a proto.TimeWindow to a TimeWindow.
+func NewTimeWindowFromProto(in *proto.TimeWindow) *TimeWindow {
+	return &TimeWindow{
+		Days:  in.Days,
+		Start: in.Start,
+		End:   in.End,
+	}
+}
+
+// ToProto returns the protobuf struct corresponding to the window.
+func (w *TimeWindow) ToProto() *proto.TimeWindow {
+	return &proto.TimeWindow{
+		Days:  w.Days,
+		Start: w.Start,
+		End:   w.End,
+	}
+}
+
+// Validate checks the window values.
+func (w *TimeWindow) Validate() error {
+	for _, d := range w.Days {
+		if _, ok := windowDays[strings.ToLower(d)]; !ok {
+			return fmt.Errorf("%s: unknown day %q", ErrWrongWindow, d)
+		}
+	}
+	for _, v := range []string{w.Start, w.End} {
+		if _, err := time.Parse("15:04", v); err != nil {
+			return fmt.Errorf("%s: %s", ErrWrongWindow, err)
+		}
+	}
+	return nil
+}
+
+// Contains returns if t is within the window, in the location of t.
+func (w *TimeWindow) Contains(t time.Time) bool {
+	start, _ := time.Parse("15:04", w.Start)
+	end, _ := time.Parse("15:04", w.End)
+	from := start.Hour()*60 + start.Minute()
+	to := end.Hour()*60 + end.Minute()
+	now := t.Hour()*60 + t.Minute()
+
+	if from < to {
+		return now >= from && now < to && w.opensOn(t.Weekday())
+	}
+	// The window closes the day after it opens
+	if now >= from {
+		return w.opensOn(t.Weekday())
+	}
+	return now < to && w.opensOn(t.AddDate(0, 0, -1).Weekday())
+}
+
+// opensOn returns if the window opens on the day.
+func (w *TimeWindow) opensOn(day time.Weekday) bool {
+	if len(w.Days) == 0 {
+		return true
+	}
+	for _, d := range w.Days {
+		if windowDays[strings.ToLower(d)] == day {
+			return true
+		}
+	}
+	return false
+}
+
+// location returns the location of the job timezone, the local one if not set.
+func (j *Job) location() *time.Location {
+	if j.Timezone != "" {
+		if loc, err := time.LoadLocation(j.Timezone); err == nil {
+			return loc
+		}
+	}
+	return time.Local
+}
+
+// inAllowedWindow returns if the job can start at t, jobs without windows can always start.
+func (j *Job) inAllowedWindow(t time.Time) bool {
+	if len(j.AllowedWindows) == 0 {
+		return true
+	}
+	t = t.In(j.location())
+	for _, w := range j.AllowedWindows {
+		if w.Contains(t) {
+			return true
+		}
+	}
+	return false
+}
+
+// recordSkipped stores a skipped execution of the job so the skipped
+// run shows in the job executions. This only works on the leader.
+func (a *Agent) recordSkipped(job *Job, reason string) {
+	now := time.Now()
+	ex := NewExecution(job.Name)
+	ex.StartedAt = now
+	ex.FinishedAt = now
+	ex.NodeName = a.config.NodeName
+	ex.Skipped = true
+	ex.SkipReason = reason
+
+	log.WithFields(logrus.Fields{
+		"job":    job.Name,
+		"reason": reason,
+	}).Info("agent: Skipping execution")
+
+	cmd, err := Encode(SetExecutionType, ex.ToProto())
+	if err != nil {
+		log.WithError(err).Error("agent: Error encoding skipped execution")
+		return
+	}
+	if err := a.raft.Apply(cmd, raftTimeout).Error(); err != nil {
+		log.WithError(err).WithField("job", job.Name).Error("agent: Error storing skipped execution")
+	}
+}
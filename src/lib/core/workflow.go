@@ -0,0 +1,196 @@
+package core
+
+import (
+	"errors"
+	"strconv"
+
+	"github.com/tidwall/buntdb"
+)
+
+const (
+	// WorkflowSucceeded means every execution of the run succeeded.
+	WorkflowSucceeded = "succeeded"
+	// WorkflowFailed means every execution of the run failed.
+	WorkflowFailed = "failed"
+	// WorkflowPartiallyFailed means some executions of the run failed and others succeeded.
+	WorkflowPartiallyFailed = "partially_failed"
+	// WorkflowRunning means some executions of the run didn't finish yet.
+	WorkflowRunning = "running"
+	// WorkflowSkipped means the run was skipped instead of executed.
+	WorkflowSkipped = "skipped"
+	// WorkflowNotRun means the job didn't run in the workflow, its edge
+	// condition wasn't met or its parents didn't finish yet.
+	WorkflowNotRun = "not_run"
+)
+
+var (
+	// ErrWorkflowRunNotFound is returned when the root job has no execution with the workflow run ID.
+	ErrWorkflowRunNotFound = errors.New("workflow run not found")
+)
+
+// WorkflowRun is a run of a job and of the dependent jobs it triggered.
+type WorkflowRun struct {
+	// Name of the job that started the workflow run.
+	Root string `json:"root"`
+
+	// Workflow run ID, shared by every execution of the run.
+	ID string `json:"id"`
+
+	// Status of the whole run, running until every job that ran finished,
+	// then failed if any of them failed.
+	Status string `json:"status"`
+
+	// Jobs of the dependency chain of the root job, the root first.
+	Nodes []*WorkflowNode `json:"nodes"`
+}
+
+// WorkflowNode is a job of a workflow run.
+type WorkflowNode struct {
+	// Name of the job.
+	JobName string `json:"job_name"`
+
+	// Parent jobs of the job in the dependency chain, empty for the root.
+	Parents []string `json:"parents"`
+
+	// Status of the run of the job, one of the Workflow* values.
+	Status string `json:"status"`
+
+	// Executions of the job in the workflow run.
+	Executions []*Execution `json:"executions"`
+}
+
+// workflowRunID returns the workflow run ID of an execution group,
+// executions stored without one start their own workflow run.
+func workflowRunID(group []*Execution) string {
+	if len(group) == 0 {
+		return ""
+	}
+	if group[0].WorkflowRun != "" {
+		return group[0].WorkflowRun
+	}
+	return strconv.FormatInt(group[0].Group, 10)
+}
+
+// workflowStatus returns the status of the executions of a job in a workflow run,
+// taking only the last attempt of every node into account.
+func workflowStatus(executions []*Execution) string {
+	if len(executions) == 0 {
+		return WorkflowNotRun
+	}
+
+	last := make(map[string]*Execution)
+	for _, e := range executions {
+		if l, ok := last[e.NodeName]; !ok || e.Attempt > l.Attempt {
+			last[e.NodeName] = e
+		}
+	}
+
+	success, failed, skipped := 0, 0, 0
+	for _, e := range last {
+		switch {
+		case e.Skipped:
+			skipped++
+		case e.FinishedAt.IsZero():
+			return WorkflowRunning
+		case e.Success:
+			success++
+		default:
+			failed++
+		}
+	}
+
+	switch {
+	case skipped == len(last):
+		return WorkflowSkipped
+	case failed == 0:
+		return WorkflowSucceeded
+	case success == 0:
+		return WorkflowFailed
+	default:
+		return WorkflowPartiallyFailed
+	}
+}
+
+// childJobs returns the jobs depending on the job, on a single parent or with fan-in.
+func childJobs(jobs []*Job, name string) []*Job {
+	var children []*Job
+	for _, j := range jobs {
+		if j.ParentJob == name || j.hasParent(name) {
+			children = append(children, j)
+		}
+	}
+	return children
+}
+
+// getWorkflowRun returns the workflow run of the root job with the given ID,
+// with the status of every job of the dependency chain of the root.
+func getWorkflowRun(store Storage, root, id string) (*WorkflowRun, error) {
+	rootJob, err := store.GetJob(root, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	jobs, err := store.GetJobs(nil)
+	if err != nil {
+		return nil, err
+	}
+
+	run := &WorkflowRun{
+		Root:   root,
+		ID:     id,
+		Status: WorkflowSucceeded,
+		Nodes:  []*WorkflowNode{},
+	}
+
+	visited := map[string]bool{root: true}
+	queue := []*Job{rootJob}
+	for len(queue) > 0 {
+		job := queue[0]
+		queue = queue[1:]
+
+		executions, err := store.GetExecutions(job.Name, &ExecutionOptions{
+			Timezone: job.GetTimeLocation(),
+		})
+		if err != nil && err != buntdb.ErrNotFound {
+			return nil, err
+		}
+
+		node := &WorkflowNode{
+			JobName:    job.Name,
+			Parents:    []string{},
+			Executions: []*Execution{},
+		}
+		if job.Name != root {
+			node.Parents = job.parents()
+		}
+		for _, e := range executions {
+			if workflowRunID([]*Execution{e}) == id {
+				node.Executions = append(node.Executions, e)
+			}
+		}
+		node.Status = workflowStatus(node.Executions)
+
+		if job.Name == root && len(node.Executions) == 0 {
+			return nil, ErrWorkflowRunNotFound
+		}
+
+		switch node.Status {
+		case WorkflowRunning:
+			run.Status = WorkflowRunning
+		case WorkflowFailed, WorkflowPartiallyFailed:
+			if run.Status != WorkflowRunning {
+				run.Status = WorkflowFailed
+			}
+		}
+		run.Nodes = append(run.Nodes, node)
+
+		for _, child := range childJobs(jobs, job.Name) {
+			if !visited[child.Name] {
+				visited[child.Name] = true
+				queue = append(queue, child)
+			}
+		}
+	}
+
+	return run, nil
+}
@@ -0,0 +1,37 @@
+package extcron
+
+import (
+	"time"
+)
+
+// AfterCompletionSchedule runs an interval after the previous run finished,
+// unlike @every that runs on fixed intervals whatever the run duration.
+// The scheduler arms every run from the finish time of the previous one.
+type AfterCompletionSchedule struct {
+	Interval time.Duration
+}
+
+// AfterCompletion returns a schedule running the interval after every completion.
+func AfterCompletion(interval time.Duration) AfterCompletionSchedule {
+	return AfterCompletionSchedule{
+		Interval: interval,
+	}
+}
+
+// Next returns the time an interval after t, as if the run finished right away.
+func (schedule AfterCompletionSchedule) Next(t time.Time) time.Time {
+	return t.Add(schedule.Interval)
+}
+
+// NextAfter returns the next run given the finish time of the previous one,
+// a zero finished time means the job never ran. Overdue runs are due at now.
+func (schedule AfterCompletionSchedule) NextAfter(finished, now time.Time) time.Time {
+	if finished.IsZero() {
+		return now.Add(schedule.Interval)
+	}
+	next := finished.Add(schedule.Interval)
+	if next.Before(now) {
+		return now
+	}
+	return next
+}
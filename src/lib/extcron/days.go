@@ -0,0 +1,249 @@
+package extcron
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/robfig/cron/v3"
+)
+
+const (
+	domField = 3
+	dowField = 5
+
+	// maxDaySearch limits the days looked at for the next run, so rules
+	// that never match, like 31W in February, don't loop forever.
+	maxDaySearch = 366 * 5
+)
+
+// dayRuleKind is the kind of a Quartz-style day field.
+type dayRuleKind int
+
+const (
+	// lastDay matches the last day of the month minus an offset: L, L-3.
+	lastDay dayRuleKind = iota
+	// lastWeekday matches the last Monday to Friday of the month: LW.
+	lastWeekday
+	// nearestWeekday matches the Monday to Friday nearest to a day of the same month: 15W.
+	nearestWeekday
+	// nthWeekday matches the nth weekday of the month: 2#2 for the 2nd Tuesday.
+	nthWeekday
+	// lastWeekdayOf matches the last weekday of the month: 5L for the last Friday.
+	lastWeekdayOf
+)
+
+// dayRule is a day of the month robfig/cron day fields can't express.
+type dayRule struct {
+	kind    dayRuleKind
+	day     int
+	weekday time.Weekday
+	nth     int
+}
+
+// DaySchedule runs at the times of a spec on the days matched by a
+// Quartz-style L, W or # day field.
+type DaySchedule struct {
+	// Schedule with the day fields set to every day.
+	Schedule cron.Schedule
+	rule     dayRule
+}
+
+// Next returns the next time of the schedule after t on a matching day,
+// the days are matched in the timezone of the spec.
+func (schedule DaySchedule) Next(t time.Time) time.Time {
+	loc := t.Location()
+	if spec, ok := schedule.Schedule.(*cron.SpecSchedule); ok {
+		loc = spec.Location
+	}
+
+	for i := 0; i < maxDaySearch; i++ {
+		t = schedule.Schedule.Next(t)
+		if t.IsZero() || schedule.rule.match(t.In(loc)) {
+			return t
+		}
+		// Continue from the end of the day
+		y, m, d := t.In(loc).Date()
+		t = time.Date(y, m, d+1, 0, 0, 0, 0, loc).Add(-time.Nanosecond).In(t.Location())
+	}
+	return time.Time{}
+}
+
+// match returns if the day of t matches the rule.
+func (r dayRule) match(t time.Time) bool {
+	y, m, d := t.Date()
+	last := daysIn(y, m)
+
+	switch r.kind {
+	case lastDay:
+		return d == last-r.day
+	case lastWeekday:
+		return d == nearestWeekdayTo(y, m, last)
+	case nearestWeekday:
+		return r.day <= last && d == nearestWeekdayTo(y, m, r.day)
+	case nthWeekday:
+		return t.Weekday() == r.weekday && (d-1)/7+1 == r.nth
+	case lastWeekdayOf:
+		return t.Weekday() == r.weekday && d+7 > last
+	}
+	return false
+}
+
+// daysIn returns the number of days of the month.
+func daysIn(year int, month time.Month) int {
+	return time.Date(year, month+1, 0, 0, 0, 0, 0, time.UTC).Day()
+}
+
+// nearestWeekdayTo returns the Monday to Friday nearest to the day without
+// leaving the month, like the W field of Quartz.
+func nearestWeekdayTo(year int, month time.Month, day int) int {
+	last := daysIn(year, month)
+	switch time.Date(year, month, day, 0, 0, 0, 0, time.UTC).Weekday() {
+	case time.Saturday:
+		if day == 1 {
+			return day + 2
+		}
+		return day - 1
+	case time.Sunday:
+		if day == last {
+			return day - 2
+		}
+		return day + 1
+	}
+	return day
+}
+
+// parseDaySpec parses the specs with a Quartz-style day field, the rest of the
+// fields are parsed by the parser with the day fields set to every day.
+// It returns false when the spec has no such field.
+func parseDaySpec(spec string, parser cron.Parser) (cron.Schedule, bool, error) {
+	prefix, fields := splitTimezone(spec)
+	if len(fields) != 6 {
+		return nil, false, nil
+	}
+
+	dom, dow := fields[domField], fields[dowField]
+	domSpecial := strings.ContainsAny(dom, "LW")
+	dowSpecial := strings.ContainsAny(dow, "L#")
+	if !domSpecial && !dowSpecial {
+		return nil, false, nil
+	}
+	if (domSpecial && !isAny(dow)) || (dowSpecial && !isAny(dom)) {
+		return nil, true, fmt.Errorf("failed to parse %s: the other day field must be * or ?", spec)
+	}
+
+	var rule dayRule
+	var err error
+	if domSpecial {
+		rule, err = parseDomRule(dom)
+	} else {
+		rule, err = parseDowRule(dow)
+	}
+	if err != nil {
+		return nil, true, fmt.Errorf("failed to parse %s: %s", spec, err)
+	}
+
+	fields[domField], fields[dowField] = "*", "*"
+	s, err := parser.Parse(prefix + strings.Join(fields, " "))
+	if err != nil {
+		return nil, true, err
+	}
+	return DaySchedule{Schedule: s, rule: rule}, true, nil
+}
+
+// parseDomRule parses the L, L-n, LW and nW day of month fields.
+func parseDomRule(field string) (dayRule, error) {
+	switch {
+	case field == "L":
+		return dayRule{kind: lastDay}, nil
+	case field == "LW":
+		return dayRule{kind: lastWeekday}, nil
+	case strings.HasPrefix(field, "L-"):
+		n, err := strconv.Atoi(field[2:])
+		if err != nil || n < 0 || n > 30 {
+			return dayRule{}, fmt.Errorf("invalid offset in %s", field)
+		}
+		return dayRule{kind: lastDay, day: n}, nil
+	case strings.HasSuffix(field, "W"):
+		n, err := strconv.Atoi(strings.TrimSuffix(field, "W"))
+		if err != nil || n < 1 || n > 31 {
+			return dayRule{}, fmt.Errorf("invalid day in %s", field)
+		}
+		return dayRule{kind: nearestWeekday, day: n}, nil
+	}
+	return dayRule{}, fmt.Errorf("unsupported day of month %s", field)
+}
+
+// parseDowRule parses the d#n and dL day of week fields.
+func parseDowRule(field string) (dayRule, error) {
+	if i := strings.Index(field, "#"); i >= 0 {
+		wd, err := parseWeekday(field[:i])
+		if err != nil {
+			return dayRule{}, err
+		}
+		n, err := strconv.Atoi(field[i+1:])
+		if err != nil || n < 1 || n > 5 {
+			return dayRule{}, fmt.Errorf("invalid week in %s, use 1 to 5", field)
+		}
+		return dayRule{kind: nthWeekday, weekday: wd, nth: n}, nil
+	}
+	if strings.HasSuffix(field, "L") {
+		wd, err := parseWeekday(strings.TrimSuffix(field, "L"))
+		if err != nil {
+			return dayRule{}, err
+		}
+		return dayRule{kind: lastWeekdayOf, weekday: wd}, nil
+	}
+	return dayRule{}, fmt.Errorf("unsupported day of week %s", field)
+}
+
+var weekdayNames = map[string]time.Weekday{
+	"SUN": time.Sunday,
+	"MON": time.Monday,
+	"TUE": time.Tuesday,
+	"WED": time.Wednesday,
+	"THU": time.Thursday,
+	"FRI": time.Friday,
+	"SAT": time.Saturday,
+}
+
+// parseWeekday parses a day of week number, 0 or 7 for Sunday, or name.
+func parseWeekday(s string) (time.Weekday, error) {
+	if wd, ok := weekdayNames[strings.ToUpper(s)]; ok {
+		return wd, nil
+	}
+	n, err := strconv.Atoi(s)
+	if err != nil || n < 0 || n > 7 {
+		return 0, fmt.Errorf("invalid day of week %q", s)
+	}
+	return time.Weekday(n % 7), nil
+}
+
+// isAny returns if the field matches every value.
+func isAny(field string) bool {
+	return field == "*" || field == "?"
+}
+
+// splitTimezone splits the TZ or CRON_TZ prefix from the fields of the spec.
+func splitTimezone(spec string) (string, []string) {
+	fields := strings.Fields(spec)
+	if len(fields) > 0 && (strings.HasPrefix(fields[0], "TZ=") || strings.HasPrefix(fields[0], "CRON_TZ=")) {
+		return fields[0] + " ", fields[1:]
+	}
+	return "", fields
+}
+
+// monthlyWeekdaySpec converts the @monthly-first-weekday and @monthly-last-weekday
+// descriptors, with an optional HH:MM time, to a spec with a W day field.
+func monthlyWeekdaySpec(descriptor, day, spec string) (string, error) {
+	hour, minute := 0, 0
+	if at := strings.TrimSpace(strings.TrimPrefix(spec, descriptor)); at != "" {
+		t, err := time.Parse("15:04", at)
+		if err != nil {
+			return "", fmt.Errorf("failed to parse time %s: %s", spec, err)
+		}
+		hour, minute = t.Hour(), t.Minute()
+	}
+	return fmt.Sprintf("0 %d %d %s * *", minute, hour, day), nil
+}
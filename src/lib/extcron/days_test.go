@@ -0,0 +1,200 @@
+package extcron
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestDaySchedule(t *testing.T) {
+	tests := []struct {
+		name string
+		spec string
+		from string
+		want []string
+	}{
+		{
+			name: "L on leap February",
+			spec: "0 0 12 L * *",
+			from: "2024-02-01T00:00:00Z",
+			want: []string{"2024-02-29T12:00:00Z", "2024-03-31T12:00:00Z"},
+		},
+		{
+			name: "L on non leap February",
+			spec: "0 0 12 L * *",
+			from: "2023-02-01T00:00:00Z",
+			want: []string{"2023-02-28T12:00:00Z", "2023-03-31T12:00:00Z"},
+		},
+		{
+			name: "L across the year",
+			spec: "0 0 12 L * *",
+			from: "2023-12-31T12:00:00Z",
+			want: []string{"2024-01-31T12:00:00Z"},
+		},
+		{
+			name: "L-1 on leap February",
+			spec: "0 0 12 L-1 * *",
+			from: "2024-02-01T00:00:00Z",
+			want: []string{"2024-02-28T12:00:00Z", "2024-03-30T12:00:00Z"},
+		},
+		{
+			name: "LW on a month ending on Saturday",
+			spec: "0 0 12 LW * *",
+			from: "2024-08-01T00:00:00Z",
+			want: []string{"2024-08-30T12:00:00Z", "2024-09-30T12:00:00Z"},
+		},
+		{
+			name: "LW on months ending on Sunday",
+			spec: "0 0 12 LW * *",
+			from: "2024-03-01T00:00:00Z",
+			want: []string{"2024-03-29T12:00:00Z", "2024-04-30T12:00:00Z", "2024-05-31T12:00:00Z", "2024-06-28T12:00:00Z"},
+		},
+		{
+			name: "1W on a Saturday stays in the month",
+			spec: "0 0 12 1W * *",
+			from: "2024-06-01T00:00:00Z",
+			want: []string{"2024-06-03T12:00:00Z", "2024-07-01T12:00:00Z"},
+		},
+		{
+			name: "1W on a Sunday",
+			spec: "0 0 12 1W * *",
+			from: "2024-08-15T00:00:00Z",
+			want: []string{"2024-09-02T12:00:00Z"},
+		},
+		{
+			name: "31W on a Sunday stays in the month",
+			spec: "0 0 12 31W * *",
+			from: "2024-03-01T00:00:00Z",
+			want: []string{"2024-03-29T12:00:00Z"},
+		},
+		{
+			name: "31W on a Saturday",
+			spec: "0 0 12 31W * *",
+			from: "2024-08-01T00:00:00Z",
+			want: []string{"2024-08-30T12:00:00Z"},
+		},
+		{
+			name: "31W skips months without a 31st",
+			spec: "0 0 12 31W * *",
+			from: "2024-04-01T00:00:00Z",
+			want: []string{"2024-05-31T12:00:00Z", "2024-07-31T12:00:00Z"},
+		},
+		{
+			name: "29W on Feb 29 only in leap years",
+			spec: "0 0 12 29W 2 *",
+			from: "2024-01-01T00:00:00Z",
+			want: []string{"2024-02-29T12:00:00Z", "2028-02-29T12:00:00Z"},
+		},
+		{
+			name: "29W on a Sunday Feb 29",
+			spec: "0 0 12 29W 2 *",
+			from: "2032-01-01T00:00:00Z",
+			want: []string{"2032-02-27T12:00:00Z"},
+		},
+		{
+			name: "30W never in February",
+			spec: "0 0 12 30W 2 *",
+			from: "2024-01-01T00:00:00Z",
+			want: []string{""},
+		},
+		{
+			name: "5#5 skips months without a fifth Friday",
+			spec: "0 0 12 * * 5#5",
+			from: "2024-01-01T00:00:00Z",
+			want: []string{"2024-03-29T12:00:00Z", "2024-05-31T12:00:00Z"},
+		},
+		{
+			name: "4#5 on Feb 29",
+			spec: "0 0 12 * * 4#5",
+			from: "2024-02-01T00:00:00Z",
+			want: []string{"2024-02-29T12:00:00Z"},
+		},
+		{
+			name: "7#1 is the first Sunday",
+			spec: "0 0 12 * * 7#1",
+			from: "2024-09-02T00:00:00Z",
+			want: []string{"2024-10-06T12:00:00Z"},
+		},
+		{
+			name: "MON#1 by name",
+			spec: "0 0 12 * * MON#1",
+			from: "2024-06-02T00:00:00Z",
+			want: []string{"2024-06-03T12:00:00Z", "2024-07-01T12:00:00Z"},
+		},
+		{
+			name: "5L on leap February",
+			spec: "0 0 12 * * 5L",
+			from: "2024-02-01T00:00:00Z",
+			want: []string{"2024-02-23T12:00:00Z", "2024-03-29T12:00:00Z"},
+		},
+		{
+			name: "L in the timezone of the spec",
+			spec: "CRON_TZ=America/New_York 0 0 23 L * *",
+			from: "2024-01-31T00:00:00Z",
+			want: []string{"2024-02-01T04:00:00Z", "2024-03-01T04:00:00Z"},
+		},
+		{
+			name: "monthly last weekday descriptor",
+			spec: "@monthly-last-weekday 17:00",
+			from: "2024-08-01T00:00:00Z",
+			want: []string{"2024-08-30T17:00:00Z"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			spec := tt.spec
+			if !strings.HasPrefix(spec, "CRON_TZ=") {
+				spec = "CRON_TZ=UTC " + spec
+			}
+			s, err := Parse(spec)
+			if err != nil {
+				t.Fatalf("Parse(%q) error: %s", spec, err)
+			}
+
+			next, err := time.Parse(time.RFC3339, tt.from)
+			if err != nil {
+				t.Fatal(err)
+			}
+			for i, w := range tt.want {
+				next = s.Next(next)
+				if w == "" {
+					if !next.IsZero() {
+						t.Fatalf("run %d: got %s, want none", i, next.UTC().Format(time.RFC3339))
+					}
+					continue
+				}
+				want, err := time.Parse(time.RFC3339, w)
+				if err != nil {
+					t.Fatal(err)
+				}
+				if !next.Equal(want) {
+					t.Fatalf("run %d: got %s, want %s", i, next.UTC().Format(time.RFC3339), w)
+				}
+			}
+		})
+	}
+}
+
+func TestDayScheduleErrors(t *testing.T) {
+	tests := []struct {
+		name string
+		spec string
+	}{
+		{"both day fields set", "0 0 12 L * MON"},
+		{"week out of range", "0 0 12 * * 5#6"},
+		{"week zero", "0 0 12 * * 5#0"},
+		{"offset out of range", "0 0 12 L-31 * *"},
+		{"day out of range", "0 0 12 32W * *"},
+		{"day zero", "0 0 12 0W * *"},
+		{"weekday out of range", "0 0 12 * * 8L"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := Parse(tt.spec); err == nil {
+				t.Fatalf("Parse(%q) succeeded, want error", tt.spec)
+			}
+		})
+	}
+}
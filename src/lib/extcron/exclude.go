@@ -0,0 +1,84 @@
+package extcron
+
+import (
+	"time"
+
+	"github.com/robfig/cron/v3"
+)
+
+// DateLayout is the layout of the excluded days.
+const DateLayout = "2006-01-02"
+
+// maxExcludedDays limits the excluded days skipped looking for the next run,
+// so schedules that only run on excluded days don't loop forever.
+const maxExcludedDays = 3660
+
+// ExcludeSchedule wraps a schedule skipping the runs on excluded days,
+// days are compared in Location, or in the location of the run times if nil.
+type ExcludeSchedule struct {
+	Schedule cron.Schedule
+	Days     map[string]bool
+	Location *time.Location
+}
+
+// Exclude returns the schedule skipping the given days, formatted as DateLayout,
+// compared in the given location.
+func Exclude(schedule cron.Schedule, days []string, loc *time.Location) ExcludeSchedule {
+	s := ExcludeSchedule{
+		Schedule: schedule,
+		Days:     make(map[string]bool, len(days)),
+		Location: loc,
+	}
+	for _, d := range days {
+		s.Days[d] = true
+	}
+	return s
+}
+
+// Excluded returns if t is on an excluded day.
+func (schedule ExcludeSchedule) Excluded(t time.Time) bool {
+	return schedule.Days[schedule.in(t).Format(DateLayout)]
+}
+
+// in returns t in the location the days are compared in.
+func (schedule ExcludeSchedule) in(t time.Time) time.Time {
+	if schedule.Location != nil {
+		return t.In(schedule.Location)
+	}
+	return t
+}
+
+// Next returns the next run after t that is not on an excluded day.
+func (schedule ExcludeSchedule) Next(t time.Time) time.Time {
+	return schedule.NextSkipping(t, nil)
+}
+
+// NextSkipping returns the next run after t that is not on an excluded day,
+// calling skipped with the first run of every excluded day passed over.
+func (schedule ExcludeSchedule) NextSkipping(t time.Time, skipped func(time.Time)) time.Time {
+	for i := 0; i < maxExcludedDays; i++ {
+		t = schedule.Schedule.Next(t)
+		if t.IsZero() || !schedule.Excluded(t) {
+			return t
+		}
+		if skipped != nil {
+			skipped(t)
+		}
+		// Continue from the end of the excluded day
+		day := schedule.in(t)
+		y, m, d := day.Date()
+		t = time.Date(y, m, d+1, 0, 0, 0, 0, day.Location()).Add(-time.Nanosecond)
+	}
+	return time.Time{}
+}
+
+// NextIncluded returns t if it's not on an excluded day,
+// otherwise the start of the first day after it that is not excluded.
+func (schedule ExcludeSchedule) NextIncluded(t time.Time) time.Time {
+	for i := 0; i < maxExcludedDays && schedule.Excluded(t); i++ {
+		day := schedule.in(t)
+		y, m, d := day.Date()
+		t = time.Date(y, m, d+1, 0, 0, 0, 0, day.Location())
+	}
+	return t
+}
@@ -0,0 +1,47 @@
+package extcron
+
+import (
+	"testing"
+	"time"
+
+	"github.com/robfig/cron/v3"
+)
+
+func TestExcludeLocation(t *testing.T) {
+	tokyo, err := time.LoadLocation("Asia/Tokyo")
+	if err != nil {
+		t.Skip(err)
+	}
+	// 23:30 of the 1st in Tokyo
+	from := time.Date(2024, 1, 1, 14, 30, 0, 0, time.UTC)
+
+	tests := []struct {
+		name string
+		loc  *time.Location
+		want time.Time
+	}{
+		{
+			name: "days in the location",
+			loc:  tokyo,
+			// One interval after the end of the excluded day
+			want: time.Date(2024, 1, 3, 0, 59, 59, 0, tokyo),
+		},
+		{
+			name: "days in the location of the run times",
+			want: time.Date(2024, 1, 1, 15, 30, 0, 0, time.UTC),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := Exclude(cron.Every(time.Hour), []string{"2024-01-02"}, tt.loc)
+			got := s.Next(from)
+			if !got.Equal(tt.want) {
+				t.Fatalf("got %s, want %s", got, tt.want)
+			}
+			if s.Excluded(got) {
+				t.Fatalf("got %s on an excluded day", got)
+			}
+		})
+	}
+}
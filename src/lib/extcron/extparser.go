@@ -8,17 +8,40 @@ import (
 	"github.com/robfig/cron/v3"
 )
 
+const (
+	// MonthlyFirstWeekday runs on the first Monday to Friday of the month, at an optional HH:MM time.
+	MonthlyFirstWeekday = "@monthly-first-weekday"
+	// MonthlyLastWeekday runs on the last Monday to Friday of the month, at an optional HH:MM time.
+	MonthlyLastWeekday = "@monthly-last-weekday"
+)
+
+// monthlyWeekdays maps the descriptors to their day of month field.
+var monthlyWeekdays = map[string]string{
+	MonthlyFirstWeekday: "1W",
+	MonthlyLastWeekday:  "LW",
+}
+
 type ExtParser struct {
 	parser cron.Parser
+	key    string
 }
 
+// cronParser parses the standard 6-field specs and descriptors for every ExtParser.
+var cronParser = cron.NewParser(cron.Second | cron.Minute | cron.Hour | cron.Dom | cron.Month | cron.Dow | cron.Descriptor)
+
 var standaloneParser = NewParser()
 
 func NewParser() cron.ScheduleParser {
-	return ExtParser{cron.NewParser(cron.Second | cron.Minute | cron.Hour | cron.Dom | cron.Month | cron.Dow | cron.Descriptor)}
+	return ExtParser{parser: cronParser}
 }
 
-func (p ExtParser) Parser(spec string) (cron.Schedule, error) {
+// NewHashedParser returns a parser that derives the value of the H tokens from the key,
+// usually the job name.
+func NewHashedParser(key string) cron.ScheduleParser {
+	return ExtParser{parser: cronParser, key: key}
+}
+
+func (p ExtParser) Parse(spec string) (cron.Schedule, error) {
 	if spec == "@manually" {
 		return At(time.Time{}), nil
 	}
@@ -31,9 +54,46 @@ func (p ExtParser) Parser(spec string) (cron.Schedule, error) {
 		}
 		return At(data), nil
 	}
+
+	const afterCompletion = "@after-completion "
+	if strings.HasPrefix(spec, afterCompletion) {
+		interval, err := time.ParseDuration(spec[len(afterCompletion):])
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse duration %s: %s", spec, err)
+		}
+		if interval <= 0 {
+			return nil, fmt.Errorf("non-positive interval in %s", spec)
+		}
+		return AfterCompletion(interval), nil
+	}
+
+	prefix, fields := splitTimezone(spec)
+	descriptor := strings.Join(fields, " ")
+	for d, day := range monthlyWeekdays {
+		if descriptor == d || strings.HasPrefix(descriptor, d+" ") {
+			s, err := monthlyWeekdaySpec(d, day, descriptor)
+			if err != nil {
+				return nil, err
+			}
+			spec = prefix + s
+		}
+	}
+
+	spec, err := expandHash(spec, p.key)
+	if err != nil {
+		return nil, err
+	}
+	if s, ok, err := parseDaySpec(spec, p.parser); ok {
+		return s, err
+	}
 	return p.parser.Parse(spec)
 }
 
 func Parse(spec string) (cron.Schedule, error) {
 	return standaloneParser.Parse(spec)
 }
+
+// ParseHashed parses the spec deriving the value of the H tokens from the key.
+func ParseHashed(spec, key string) (cron.Schedule, error) {
+	return NewHashedParser(key).Parse(spec)
+}
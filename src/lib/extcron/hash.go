@@ -0,0 +1,109 @@
+package extcron
+
+import (
+	"fmt"
+	"hash/fnv"
+	"strconv"
+	"strings"
+)
+
+// hashBounds are the values H picks from for every field of the 6-field spec,
+// days of month stop at 28 so the job runs every month.
+var hashBounds = [][2]int{
+	{0, 59}, // second
+	{0, 59}, // minute
+	{0, 23}, // hour
+	{1, 28}, // day of month
+	{1, 12}, // month
+	{0, 6},  // day of week
+}
+
+// expandHash replaces the H tokens of the spec with values derived from the key,
+// so every key runs at a different but stable time. The supported forms are
+// H, H(a-b), H/n and H(a-b)/n.
+func expandHash(spec, key string) (string, error) {
+	if !strings.Contains(spec, "H") || strings.HasPrefix(strings.TrimSpace(spec), "@") {
+		return spec, nil
+	}
+
+	prefix, fields := splitTimezone(spec)
+	if len(fields) != len(hashBounds) {
+		// Let the parser report the error
+		return spec, nil
+	}
+
+	for i, field := range fields {
+		if !strings.Contains(field, "H") {
+			continue
+		}
+		parts := strings.Split(field, ",")
+		for j, part := range parts {
+			v, err := expandHashPart(part, hashValue(key, i), hashBounds[i])
+			if err != nil {
+				return "", fmt.Errorf("failed to parse %s: %s", field, err)
+			}
+			parts[j] = v
+		}
+		fields[i] = strings.Join(parts, ",")
+	}
+
+	return prefix + strings.Join(fields, " "), nil
+}
+
+// expandHashPart expands a single H expression of a field.
+func expandHashPart(part string, hash uint32, bounds [2]int) (string, error) {
+	if !strings.HasPrefix(part, "H") {
+		return part, nil
+	}
+	expr := part[1:]
+	low, high := bounds[0], bounds[1]
+
+	if strings.HasPrefix(expr, "(") {
+		end := strings.Index(expr, ")")
+		if end < 0 {
+			return "", fmt.Errorf("missing ) in %s", part)
+		}
+		r := strings.SplitN(expr[1:end], "-", 2)
+		if len(r) != 2 {
+			return "", fmt.Errorf("invalid range in %s", part)
+		}
+		var err error
+		if low, err = strconv.Atoi(r[0]); err != nil {
+			return "", fmt.Errorf("invalid range in %s", part)
+		}
+		if high, err = strconv.Atoi(r[1]); err != nil {
+			return "", fmt.Errorf("invalid range in %s", part)
+		}
+		if low < bounds[0] || high > bounds[1] || low > high {
+			return "", fmt.Errorf("range out of bounds (%d-%d) in %s", bounds[0], bounds[1], part)
+		}
+		expr = expr[end+1:]
+	}
+
+	if expr == "" {
+		return strconv.Itoa(low + int(hash%uint32(high-low+1))), nil
+	}
+
+	if !strings.HasPrefix(expr, "/") {
+		return "", fmt.Errorf("unexpected %s after H", expr)
+	}
+	step, err := strconv.Atoi(expr[1:])
+	if err != nil || step <= 0 {
+		return "", fmt.Errorf("invalid step in %s", part)
+	}
+	// Spread the start of the range within the first step
+	offset := int(hash % uint32(step))
+	if offset > high-low {
+		offset = int(hash % uint32(high-low+1))
+	}
+	return fmt.Sprintf("%d-%d/%d", low+offset, high, step), nil
+}
+
+// hashValue derives the value of a field from the key, every field
+// uses a different hash so the fields aren't correlated.
+func hashValue(key string, field int) uint32 {
+	h := fnv.New32a()
+	h.Write([]byte(key))
+	h.Write([]byte{byte(field)})
+	return h.Sum32()
+}
@@ -0,0 +1,189 @@
+package extcron
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+func TestExpandHash(t *testing.T) {
+	tests := []struct {
+		name      string
+		spec      string
+		want      string
+		wantError string
+	}{
+		{
+			name: "no H",
+			spec: "0 30 * * * *",
+			want: "0 30 * * * *",
+		},
+		{
+			name: "descriptor",
+			spec: "@hourly",
+			want: "@hourly",
+		},
+		{
+			name: "wrong field count left to the parser",
+			spec: "H * * *",
+			want: "H * * *",
+		},
+		{
+			name: "timezone kept",
+			spec: "TZ=Europe/Madrid 0 0 H * * *",
+			want: "TZ=Europe/Madrid 0 0 ",
+		},
+		{
+			name:      "missing parenthesis",
+			spec:      "0 H(0-10 * * * *",
+			wantError: "missing ) in H(0-10",
+		},
+		{
+			name:      "range without dash",
+			spec:      "0 H(10) * * * *",
+			wantError: "invalid range in H(10)",
+		},
+		{
+			name:      "range not a number",
+			spec:      "0 H(a-10) * * * *",
+			wantError: "invalid range in H(a-10)",
+		},
+		{
+			name:      "range out of bounds",
+			spec:      "0 0 H(0-24) * * *",
+			wantError: "range out of bounds (0-23) in H(0-24)",
+		},
+		{
+			name:      "reversed range",
+			spec:      "0 H(30-10) * * * *",
+			wantError: "range out of bounds (0-59) in H(30-10)",
+		},
+		{
+			name:      "zero step",
+			spec:      "0 H/0 * * * *",
+			wantError: "invalid step in H/0",
+		},
+		{
+			name:      "step not a number",
+			spec:      "0 H/x * * * *",
+			wantError: "invalid step in H/x",
+		},
+		{
+			name:      "unexpected suffix",
+			spec:      "0 H5 * * * *",
+			wantError: "unexpected 5 after H",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := expandHash(tt.spec, "job1")
+			if tt.wantError != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantError) {
+					t.Fatalf("got error %v, want %q", err, tt.wantError)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !strings.HasPrefix(got, tt.want) {
+				t.Fatalf("got %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+// TestExpandHashBounds checks the values picked for every key stay in the
+// bounds of the field, or of the range, and the start of H/n is in the first step.
+func TestExpandHashBounds(t *testing.T) {
+	tests := []struct {
+		name   string
+		spec   string
+		field  int
+		low    int
+		high   int
+		step   int
+		values int
+	}{
+		{name: "second", spec: "H * * * * *", field: 0, low: 0, high: 59, values: 40},
+		{name: "minute", spec: "0 H * * * *", field: 1, low: 0, high: 59, values: 40},
+		{name: "hour", spec: "0 0 H * * *", field: 2, low: 0, high: 23, values: 20},
+		{name: "day of month", spec: "0 0 0 H * *", field: 3, low: 1, high: 28, values: 20},
+		{name: "month", spec: "0 0 0 1 H *", field: 4, low: 1, high: 12, values: 10},
+		{name: "day of week", spec: "0 0 0 * * H", field: 5, low: 0, high: 6, values: 6},
+		{name: "range", spec: "0 0 H(9-17) * * *", field: 2, low: 9, high: 17, values: 8},
+		{name: "step", spec: "0 H/15 * * * *", field: 1, low: 0, high: 59, step: 15, values: 12},
+		{name: "range and step", spec: "0 H(10-40)/10 * * * *", field: 1, low: 10, high: 40, step: 10, values: 8},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			seen := make(map[int]bool)
+			for i := 0; i < 200; i++ {
+				spec, err := expandHash(tt.spec, fmt.Sprintf("job%d", i))
+				if err != nil {
+					t.Fatal(err)
+				}
+				field := strings.Fields(spec)[tt.field]
+
+				start := field
+				if tt.step > 0 {
+					want := fmt.Sprintf("-%d/%d", tt.high, tt.step)
+					if !strings.HasSuffix(field, want) {
+						t.Fatalf("got %q, want a range ending in %q", field, want)
+					}
+					start = strings.TrimSuffix(field, want)
+				}
+				v, err := strconv.Atoi(start)
+				if err != nil {
+					t.Fatalf("got %q, want a value", field)
+				}
+				high := tt.high
+				if tt.step > 0 {
+					high = tt.low + tt.step - 1
+				}
+				if v < tt.low || v > high {
+					t.Fatalf("got %d, want a value between %d and %d", v, tt.low, high)
+				}
+				seen[v] = true
+			}
+			// The jobs are spread over the values
+			if len(seen) < tt.values {
+				t.Fatalf("got %d different values, want at least %d", len(seen), tt.values)
+			}
+		})
+	}
+}
+
+func TestExpandHashStable(t *testing.T) {
+	spec := "H H H(8-18) * * H"
+	first, err := expandHash(spec, "job1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i := 0; i < 10; i++ {
+		got, err := expandHash(spec, "job1")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got != first {
+			t.Fatalf("got %q, then %q for the same key", first, got)
+		}
+	}
+
+	other, err := expandHash(spec, "job2")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if other == first {
+		t.Fatalf("got %q for different keys", first)
+	}
+
+	// Every field uses its own hash
+	fields := strings.Fields(first)
+	if fields[0] == fields[1] && fields[1] == fields[5] {
+		t.Fatalf("got the same value for every field in %q", first)
+	}
+}
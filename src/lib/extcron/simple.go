@@ -14,10 +14,9 @@ func At(date time.Time) SimpleSchedule {
 	}
 }
 
-func (Schedule SimpleSchedule) Next(t time.Time) time.Time {
+func (schedule SimpleSchedule) Next(t time.Time) time.Time {
 	if schedule.Date.After(t) {
 		return schedule.Date
 	}
 	return time.Time{}
 }
-
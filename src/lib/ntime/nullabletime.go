@@ -11,7 +11,7 @@ type NullableTime struct {
 	time     time.Time
 }
 
-func (t *NullableTime) hasValue() bool {
+func (t *NullableTime) HasValue() bool {
 	return t.hasValue
 }
 
@@ -24,7 +24,7 @@ func (t *NullableTime) Unset() {
 	t.hasValue = false
 }
 
-func (t *NullableTime) Get() {
+func (t *NullableTime) Get() time.Time {
 	if t.hasValue {
 		return t.time
 	}
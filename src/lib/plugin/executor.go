@@ -2,8 +2,10 @@ package plugin
 
 import (
 	"context"
+	"errors"
 
 	"spiderjob/lib/plugin/types"
+	"github.com/golang/protobuf/ptypes"
 	"github.com/hashicorp/go-plugin"
 	"google.golang.org/grpc"
 )
@@ -12,11 +14,61 @@ type StatusHelper interface {
 	Update([]byte, bool) (int64, error)
 }
 
+// ResultHelper is implemented by the status helpers able to store named result
+// values while the execution runs, executors should check for it:
+//
+//	if rh, ok := cb.(plugin.ResultHelper); ok {
+//		rh.SetResult("file_path", path)
+//	}
+//
+// The results are stored with the execution and passed to the dependent jobs.
+type ResultHelper interface {
+	SetResult(name, value string) error
+}
+
 // Executor is the interface that we're exposing as a plugin.
+// Executors must stop running and return when ctx is done, the
+// context is cancelled when the execution is stopped by a user.
 type Executor interface {
+	Execute(ctx context.Context, args *types.ExecuteRequest, cb StatusHelper) (*types.ExecuteResponse, error)
+}
+
+// ExecutorV1 is the executor interface of protocol version 1 plugins,
+// it doesn't receive a context so the executor can't be cancelled.
+type ExecutorV1 interface {
 	Execute(args *types.ExecuteRequest, cb StatusHelper) (*types.ExecuteResponse, error)
 }
 
+// ExecutorV1Shim adapts an ExecutorV1 to the Executor interface so it can
+// be served and run as any other executor. The wrapped executor can't be
+// stopped, when ctx is done the call still waits for it to return.
+type ExecutorV1Shim struct {
+	V1 ExecutorV1
+}
+
+// Execute runs the wrapped executor until it finishes, returning ctx.Err()
+// instead of its result if ctx was done meanwhile.
+func (s ExecutorV1Shim) Execute(ctx context.Context, args *types.ExecuteRequest, cb StatusHelper) (*types.ExecuteResponse, error) {
+	type result struct {
+		out *types.ExecuteResponse
+		err error
+	}
+	done := make(chan result, 1)
+	go func() {
+		out, err := s.V1.Execute(args, cb)
+		done <- result{out, err}
+	}()
+
+	select {
+	case r := <-done:
+		return r.out, r.err
+	case <-ctx.Done():
+		// Don't leave the executor running behind the caller
+		<-done
+		return nil, ctx.Err()
+	}
+}
+
 // ExecutorPluginConfig is the plugin config
 type ExecutorPluginConfig map[string]string
 
@@ -37,6 +89,31 @@ func (p *ExecutorPlugin) GRPCClient(ctx context.Context, broker *plugin.GRPCBrok
 	return &ExecutorClient{client: types.NewExecutorClient(c), broker: broker}, nil
 }
 
+// ExecutorV1Plugin consumes executor plugins built for protocol version 1,
+// the wire protocol is the same but they don't handle the context.
+type ExecutorV1Plugin struct {
+	plugin.NetRPCUnsupportedPlugin
+}
+
+func (p *ExecutorV1Plugin) GRPCServer(broker *plugin.GRPCBroker, s *grpc.Server) error {
+	return errors.New("executor protocol version 1 can only be consumed")
+}
+
+func (p *ExecutorV1Plugin) GRPCClient(ctx context.Context, broker *plugin.GRPCBroker, c *grpc.ClientConn) (interface{}, error) {
+	client := &ExecutorClient{client: types.NewExecutorClient(c), broker: broker}
+	return ExecutorV1Shim{V1: &ExecutorV1Client{client: client}}, nil
+}
+
+// ExecutorV1Client calls a protocol version 1 executor plugin.
+type ExecutorV1Client struct {
+	client *ExecutorClient
+}
+
+// Execute runs the execution without deadline, version 1 plugins ignore it.
+func (m *ExecutorV1Client) Execute(args *types.ExecuteRequest, cb StatusHelper) (*types.ExecuteResponse, error) {
+	return m.client.Execute(context.Background(), args, cb)
+}
+
 // Here is the gRPC client that GRPCClient talks to.
 type ExecutorClient struct {
 	// This is the real implementation
@@ -44,7 +121,7 @@ type ExecutorClient struct {
 	broker *plugin.GRPCBroker
 }
 
-func (m *ExecutorClient) Execute(args *types.ExecuteRequest, cb StatusHelper) (*types.ExecuteResponse, error) {
+func (m *ExecutorClient) Execute(ctx context.Context, args *types.ExecuteRequest, cb StatusHelper) (*types.ExecuteResponse, error) {
 	// This is where the magic conversion to Proto happens
 	statusHelperServer := &GRPCStatusHelperServer{Impl: cb}
 
@@ -60,7 +137,10 @@ func (m *ExecutorClient) Execute(args *types.ExecuteRequest, cb StatusHelper) (*
 	go m.broker.AcceptAndServe(brokerID, serverFunc)
 
 	args.StatusServer = brokerID
-	r, err := m.client.Execute(context.Background(), args)
+	if deadline, ok := ctx.Deadline(); ok {
+		args.Deadline, _ = ptypes.TimestampProto(deadline)
+	}
+	r, err := m.client.Execute(ctx, args)
 
 	s.Stop()
 	return r, err
@@ -82,8 +162,19 @@ func (m ExecutorServer) Execute(ctx context.Context, req *types.ExecuteRequest)
 	}
 	defer conn.Close()
 
+	// Enforce the deadline sent by the agent even if it
+	// didn't reach us as part of the gRPC context.
+	if req.Deadline != nil {
+		deadline, err := ptypes.Timestamp(req.Deadline)
+		if err == nil {
+			var cancel context.CancelFunc
+			ctx, cancel = context.WithDeadline(ctx, deadline)
+			defer cancel()
+		}
+	}
+
 	a := &GRPCStatusHelperClient{types.NewStatusHelperClient(conn)}
-	return m.Impl.Execute(req, a)
+	return m.Impl.Execute(ctx, req, a)
 }
 
 // GRPCStatusHelperClient is an implementation of status updates over RPC.
@@ -100,6 +191,14 @@ func (m *GRPCStatusHelperClient) Update(b []byte, c bool) (int64, error) {
 	return resp.R, err
 }
 
+// SetResult sends a named result value of the execution.
+func (m *GRPCStatusHelperClient) SetResult(name, value string) error {
+	_, err := m.client.Update(context.Background(), &types.StatusUpdateRequest{
+		Results: map[string]string{name: value},
+	})
+	return err
+}
+
 // GRPCStatusHelperServer is the gRPC server that GRPCClient talks to.
 type GRPCStatusHelperServer struct {
 	// This is the real implementation
@@ -108,6 +207,20 @@ type GRPCStatusHelperServer struct {
 }
 
 func (m *GRPCStatusHelperServer) Update(ctx context.Context, req *types.StatusUpdateRequest) (resp *types.StatusUpdateResponse, err error) {
+	// Result updates don't carry output
+	if len(req.Results) > 0 {
+		rh, ok := m.Impl.(ResultHelper)
+		if !ok {
+			return &types.StatusUpdateResponse{}, nil
+		}
+		for name, value := range req.Results {
+			if err := rh.SetResult(name, value); err != nil {
+				return nil, err
+			}
+		}
+		return &types.StatusUpdateResponse{}, nil
+	}
+
 	r, err := m.Impl.Update(req.Output, req.Error)
 	if err != nil {
 		return nil, err
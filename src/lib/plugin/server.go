@@ -1,6 +1,8 @@
 package plugin
 
 import (
+	"os/exec"
+
 	"github.com/hashicorp/go-plugin"
 )
 
@@ -10,15 +12,44 @@ const (
 	ExecutorPluginName  = "executor"
 )
 
+// Handshake is the handshake used by plugins built with this package.
+// Version 2 executors receive a context and the execution deadline, executors
+// still implementing the version 1 interface are served with ServeOpts.ExecutorV1.
+// Plugins built before version 2 are still loaded through VersionedPlugins.
 var Handshake = plugin.HandshakeConfig{
-	ProtocolVersion: 1,
+	ProtocolVersion: 2,
 	MagicCookieKey: "SPIDERJOB_PLUGIN_MAGIC_COOKIE",
-	MagicCookieValue: "1234567"
+	MagicCookieValue: "1234567",
+}
+
+// VersionedPlugins are the plugin sets the agent is able to consume for every
+// supported protocol version. Version 1 executors are wrapped in an ExecutorV1Shim,
+// they don't get the deadline and can't be cancelled.
+var VersionedPlugins = map[int]plugin.PluginSet{
+	1: {
+		ProcessorPluginName: &ProcessorPlugin{},
+		ExecutorPluginName:  &ExecutorV1Plugin{},
+	},
+	2: PluginMap,
+}
+
+// ClientConfig returns the config used by the agent to load a plugin binary,
+// the protocol version is negotiated with the plugin.
+func ClientConfig(cmd *exec.Cmd) *plugin.ClientConfig {
+	return &plugin.ClientConfig{
+		HandshakeConfig:  Handshake,
+		VersionedPlugins: VersionedPlugins,
+		Cmd:              cmd,
+		AllowedProtocols: []plugin.Protocol{plugin.ProtocolGRPC},
+	}
 }
 
 type ServeOpts struct {
 	Processor Processor
 	Executor Executor
+	// ExecutorV1 allows serving an executor still implementing the
+	// version 1 interface, it's wrapped in an ExecutorV1Shim.
+	ExecutorV1 ExecutorV1
 }
 
 func Serve(opts *ServeOpts) {
@@ -29,7 +60,13 @@ func Serve(opts *ServeOpts) {
 }
 
 func pluginMap(opts *ServeOpts) map[string]plugin.Plugin {
+	executor := opts.Executor
+	if executor == nil && opts.ExecutorV1 != nil {
+		executor = ExecutorV1Shim{V1: opts.ExecutorV1}
+	}
+
 	return map[string]plugin.Plugin{
-		"processor": &ProcessorPlugin{Processor: opts.Processor},
+		ProcessorPluginName: &ProcessorPlugin{Processor: opts.Processor},
+		ExecutorPluginName:  &ExecutorPlugin{Executor: executor},
 	}
 }
\ No newline at end of file
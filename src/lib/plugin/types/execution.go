@@ -0,0 +1,8 @@
+package types
+
+import "fmt"
+
+// Key computes the execution key
+func (e *Execution) Key() string {
+	return fmt.Sprintf("%d-%s", e.StartedAt.AsTime().UnixNano(), e.NodeName)
+}
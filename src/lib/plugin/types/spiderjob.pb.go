@@ -1,7489 +1,4133 @@
-// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// protoc -I proto/ --go_out=plugin/types --go_opt=paths=source_relative --go-grpc_out=plugin/types --go-grpc_opt=paths=source_relative spiderjob.proto
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.25.0
+// 	protoc        (unknown)
 // source: spiderjob.proto
 
 package types
 
 import (
-	context "context"
-	fmt "fmt"
 	proto "github.com/golang/protobuf/proto"
-	grpc "google.golang.org/grpc"
-	codes "google.golang.org/grpc/codes"
-	status "google.golang.org/grpc/status"
-	// protobuf "google/protobuf"
-	io "io"
-	math "math"
-	math_bits "math/bits"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
 )
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ = proto.Marshal
-var _ = fmt.Errorf
-var _ = math.Inf
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
 
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the proto package it is being compiled against.
-// A compilation error at this line likely means your copy of the
-// proto package needs to be updated.
-const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
+// This is a compile-time assertion that a sufficiently up-to-date version
+// of the legacy proto package is being used.
+const _ = proto.ProtoPackageIsVersion4
 
 type Job struct {
-	Name                 string                   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Timezone             string                   `protobuf:"bytes,2,opt,name=timezone,proto3" json:"timezone,omitempty"`
-	Schedule             string                   `protobuf:"bytes,3,opt,name=schedule,proto3" json:"schedule,omitempty"`
-	Owner                string                   `protobuf:"bytes,7,opt,name=owner,proto3" json:"owner,omitempty"`
-	OwnerEmail           string                   `protobuf:"bytes,8,opt,name=owner_email,json=ownerEmail,proto3" json:"owner_email,omitempty"`
-	SuccessCount         int32                    `protobuf:"varint,9,opt,name=success_count,json=successCount,proto3" json:"success_count,omitempty"`
-	ErrorCount           int32                    `protobuf:"varint,10,opt,name=error_count,json=errorCount,proto3" json:"error_count,omitempty"`
-	Disabled             bool                     `protobuf:"varint,11,opt,name=disabled,proto3" json:"disabled,omitempty"`
-	Tags                 map[string]string        `protobuf:"bytes,12,rep,name=tags,proto3" json:"tags,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	Retries              uint32                   `protobuf:"varint,13,opt,name=retries,proto3" json:"retries,omitempty"`
-	DependentJobs        []string                 `protobuf:"bytes,14,rep,name=dependent_jobs,json=dependentJobs,proto3" json:"dependent_jobs,omitempty"`
-	ParentJob            string                   `protobuf:"bytes,15,opt,name=parent_job,json=parentJob,proto3" json:"parent_job,omitempty"`
-	Concurrency          string                   `protobuf:"bytes,16,opt,name=concurrency,proto3" json:"concurrency,omitempty"`
-	Executor             string                   `protobuf:"bytes,17,opt,name=executor,proto3" json:"executor,omitempty"`
-	ExecutorConfig       map[string]string        `protobuf:"bytes,18,rep,name=executor_config,json=executorConfig,proto3" json:"executor_config,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	Status               string                   `protobuf:"bytes,19,opt,name=status,proto3" json:"status,omitempty"`
-	Metadata             map[string]string        `protobuf:"bytes,20,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	LastSuccess          *Job_NullableTime        `protobuf:"bytes,25,opt,name=last_success,json=lastSuccess,proto3" json:"last_success,omitempty"`
-	LastError            *Job_NullableTime        `protobuf:"bytes,26,opt,name=last_error,json=lastError,proto3" json:"last_error,omitempty"`
-	Next                 *protobuf.Timestamp      `protobuf:"bytes,23,opt,name=next,proto3" json:"next,omitempty"`
-	Displayname          string                   `protobuf:"bytes,24,opt,name=displayname,proto3" json:"displayname,omitempty"`
-	Processors           map[string]*PluginConfig `protobuf:"bytes,27,rep,name=processors,proto3" json:"processors,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
-	XXX_unrecognized     []byte                   `json:"-"`
-	XXX_sizecache        int32                    `json:"-"`
-}
-
-func (m *Job) Reset()         { *m = Job{} }
-func (m *Job) String() string { return proto.CompactTextString(m) }
-func (*Job) ProtoMessage()    {}
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name            string                   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Timezone        string                   `protobuf:"bytes,2,opt,name=timezone,proto3" json:"timezone,omitempty"`
+	Schedule        string                   `protobuf:"bytes,3,opt,name=schedule,proto3" json:"schedule,omitempty"`
+	Owner           string                   `protobuf:"bytes,7,opt,name=owner,proto3" json:"owner,omitempty"`
+	OwnerEmail      string                   `protobuf:"bytes,8,opt,name=owner_email,json=ownerEmail,proto3" json:"owner_email,omitempty"`
+	SuccessCount    int32                    `protobuf:"varint,9,opt,name=success_count,json=successCount,proto3" json:"success_count,omitempty"`
+	ErrorCount      int32                    `protobuf:"varint,10,opt,name=error_count,json=errorCount,proto3" json:"error_count,omitempty"`
+	Disabled        bool                     `protobuf:"varint,11,opt,name=disabled,proto3" json:"disabled,omitempty"`
+	Tags            map[string]string        `protobuf:"bytes,12,rep,name=tags,proto3" json:"tags,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Retries         uint32                   `protobuf:"varint,13,opt,name=retries,proto3" json:"retries,omitempty"`
+	DependentJobs   []string                 `protobuf:"bytes,14,rep,name=dependent_jobs,json=dependentJobs,proto3" json:"dependent_jobs,omitempty"`
+	ParentJob       string                   `protobuf:"bytes,15,opt,name=parent_job,json=parentJob,proto3" json:"parent_job,omitempty"`
+	Concurrency     string                   `protobuf:"bytes,16,opt,name=concurrency,proto3" json:"concurrency,omitempty"`
+	Executor        string                   `protobuf:"bytes,17,opt,name=executor,proto3" json:"executor,omitempty"`
+	ExecutorConfig  map[string]string        `protobuf:"bytes,18,rep,name=executor_config,json=executorConfig,proto3" json:"executor_config,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Status          string                   `protobuf:"bytes,19,opt,name=status,proto3" json:"status,omitempty"`
+	Metadata        map[string]string        `protobuf:"bytes,20,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	LastSuccess     *Job_NullableTime        `protobuf:"bytes,25,opt,name=last_success,json=lastSuccess,proto3" json:"last_success,omitempty"`
+	LastError       *Job_NullableTime        `protobuf:"bytes,26,opt,name=last_error,json=lastError,proto3" json:"last_error,omitempty"`
+	Next            *timestamppb.Timestamp   `protobuf:"bytes,23,opt,name=next,proto3" json:"next,omitempty"`
+	Displayname     string                   `protobuf:"bytes,24,opt,name=displayname,proto3" json:"displayname,omitempty"`
+	Processors      map[string]*PluginConfig `protobuf:"bytes,27,rep,name=processors,proto3" json:"processors,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Timeout         string                   `protobuf:"bytes,28,opt,name=timeout,proto3" json:"timeout,omitempty"`
+	Retention       *RetentionPolicy         `protobuf:"bytes,29,opt,name=retention,proto3" json:"retention,omitempty"`
+	RetryPolicy     *RetryPolicy             `protobuf:"bytes,30,opt,name=retry_policy,json=retryPolicy,proto3" json:"retry_policy,omitempty"`
+	RetryPlacement  string                   `protobuf:"bytes,31,opt,name=retry_placement,json=retryPlacement,proto3" json:"retry_placement,omitempty"`
+	MissedRunPolicy *MissedRunPolicy         `protobuf:"bytes,32,opt,name=missed_run_policy,json=missedRunPolicy,proto3" json:"missed_run_policy,omitempty"`
+	Calendars       []string                 `protobuf:"bytes,33,rep,name=calendars,proto3" json:"calendars,omitempty"`
+	AllowedWindows  []*TimeWindow            `protobuf:"bytes,34,rep,name=allowed_windows,json=allowedWindows,proto3" json:"allowed_windows,omitempty"`
+	ParentJobs      []string                 `protobuf:"bytes,35,rep,name=parent_jobs,json=parentJobs,proto3" json:"parent_jobs,omitempty"`
+	FanIn           string                   `protobuf:"bytes,36,opt,name=fan_in,json=fanIn,proto3" json:"fan_in,omitempty"`
+	TriggerOn       string                   `protobuf:"bytes,37,opt,name=trigger_on,json=triggerOn,proto3" json:"trigger_on,omitempty"`
+}
+
+func (x *Job) Reset() {
+	*x = Job{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_spiderjob_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Job) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Job) ProtoMessage() {}
+
+func (x *Job) ProtoReflect() protoreflect.Message {
+	mi := &file_spiderjob_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Job.ProtoReflect.Descriptor instead.
 func (*Job) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f956fb9152fd788f, []int{0}
-}
-func (m *Job) XXX_Unmarshal(b []byte) error {
-	return m.Unmarshal(b)
-}
-func (m *Job) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	if deterministic {
-		return xxx_messageInfo_Job.Marshal(b, m, deterministic)
-	} else {
-		b = b[:cap(b)]
-		n, err := m.MarshalToSizedBuffer(b)
-		if err != nil {
-			return nil, err
-		}
-		return b[:n], nil
-	}
+	return file_spiderjob_proto_rawDescGZIP(), []int{0}
 }
-func (m *Job) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Job.Merge(m, src)
-}
-func (m *Job) XXX_Size() int {
-	return m.Size()
-}
-func (m *Job) XXX_DiscardUnknown() {
-	xxx_messageInfo_Job.DiscardUnknown(m)
-}
-
-var xxx_messageInfo_Job proto.InternalMessageInfo
 
-func (m *Job) GetName() string {
-	if m != nil {
-		return m.Name
+func (x *Job) GetName() string {
+	if x != nil {
+		return x.Name
 	}
 	return ""
 }
 
-func (m *Job) GetTimezone() string {
-	if m != nil {
-		return m.Timezone
+func (x *Job) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
 	}
 	return ""
 }
 
-func (m *Job) GetSchedule() string {
-	if m != nil {
-		return m.Schedule
+func (x *Job) GetSchedule() string {
+	if x != nil {
+		return x.Schedule
 	}
 	return ""
 }
 
-func (m *Job) GetOwner() string {
-	if m != nil {
-		return m.Owner
+func (x *Job) GetOwner() string {
+	if x != nil {
+		return x.Owner
 	}
 	return ""
 }
 
-func (m *Job) GetOwnerEmail() string {
-	if m != nil {
-		return m.OwnerEmail
+func (x *Job) GetOwnerEmail() string {
+	if x != nil {
+		return x.OwnerEmail
 	}
 	return ""
 }
 
-func (m *Job) GetSuccessCount() int32 {
-	if m != nil {
-		return m.SuccessCount
+func (x *Job) GetSuccessCount() int32 {
+	if x != nil {
+		return x.SuccessCount
 	}
 	return 0
 }
 
-func (m *Job) GetErrorCount() int32 {
-	if m != nil {
-		return m.ErrorCount
+func (x *Job) GetErrorCount() int32 {
+	if x != nil {
+		return x.ErrorCount
 	}
 	return 0
 }
 
-func (m *Job) GetDisabled() bool {
-	if m != nil {
-		return m.Disabled
+func (x *Job) GetDisabled() bool {
+	if x != nil {
+		return x.Disabled
 	}
 	return false
 }
 
-func (m *Job) GetTags() map[string]string {
-	if m != nil {
-		return m.Tags
+func (x *Job) GetTags() map[string]string {
+	if x != nil {
+		return x.Tags
 	}
 	return nil
 }
 
-func (m *Job) GetRetries() uint32 {
-	if m != nil {
-		return m.Retries
+func (x *Job) GetRetries() uint32 {
+	if x != nil {
+		return x.Retries
 	}
 	return 0
 }
 
-func (m *Job) GetDependentJobs() []string {
-	if m != nil {
-		return m.DependentJobs
+func (x *Job) GetDependentJobs() []string {
+	if x != nil {
+		return x.DependentJobs
 	}
 	return nil
 }
 
-func (m *Job) GetParentJob() string {
-	if m != nil {
-		return m.ParentJob
+func (x *Job) GetParentJob() string {
+	if x != nil {
+		return x.ParentJob
 	}
 	return ""
 }
 
-func (m *Job) GetConcurrency() string {
-	if m != nil {
-		return m.Concurrency
+func (x *Job) GetConcurrency() string {
+	if x != nil {
+		return x.Concurrency
 	}
 	return ""
 }
 
-func (m *Job) GetExecutor() string {
-	if m != nil {
-		return m.Executor
+func (x *Job) GetExecutor() string {
+	if x != nil {
+		return x.Executor
 	}
 	return ""
 }
 
-func (m *Job) GetExecutorConfig() map[string]string {
-	if m != nil {
-		return m.ExecutorConfig
+func (x *Job) GetExecutorConfig() map[string]string {
+	if x != nil {
+		return x.ExecutorConfig
 	}
 	return nil
 }
 
-func (m *Job) GetStatus() string {
-	if m != nil {
-		return m.Status
+func (x *Job) GetStatus() string {
+	if x != nil {
+		return x.Status
 	}
 	return ""
 }
 
-func (m *Job) GetMetadata() map[string]string {
-	if m != nil {
-		return m.Metadata
+func (x *Job) GetMetadata() map[string]string {
+	if x != nil {
+		return x.Metadata
 	}
 	return nil
 }
 
-func (m *Job) GetLastSuccess() *Job_NullableTime {
-	if m != nil {
-		return m.LastSuccess
+func (x *Job) GetLastSuccess() *Job_NullableTime {
+	if x != nil {
+		return x.LastSuccess
 	}
 	return nil
 }
 
-func (m *Job) GetLastError() *Job_NullableTime {
-	if m != nil {
-		return m.LastError
+func (x *Job) GetLastError() *Job_NullableTime {
+	if x != nil {
+		return x.LastError
 	}
 	return nil
 }
 
-func (m *Job) GetNext() *protobuf.Timestamp {
-	if m != nil {
-		return m.Next
+func (x *Job) GetNext() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Next
 	}
 	return nil
 }
 
-func (m *Job) GetDisplayname() string {
-	if m != nil {
-		return m.Displayname
+func (x *Job) GetDisplayname() string {
+	if x != nil {
+		return x.Displayname
 	}
 	return ""
 }
 
-func (m *Job) GetProcessors() map[string]*PluginConfig {
-	if m != nil {
-		return m.Processors
+func (x *Job) GetProcessors() map[string]*PluginConfig {
+	if x != nil {
+		return x.Processors
 	}
 	return nil
 }
 
-type Job_NullableTime struct {
-	HasValue             bool                `protobuf:"varint,1,opt,name=has_value,json=hasValue,proto3" json:"has_value,omitempty"`
-	Time                 *protobuf.Timestamp `protobuf:"bytes,2,opt,name=time,proto3" json:"time,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
-	XXX_unrecognized     []byte              `json:"-"`
-	XXX_sizecache        int32               `json:"-"`
+func (x *Job) GetTimeout() string {
+	if x != nil {
+		return x.Timeout
+	}
+	return ""
 }
 
-func (m *Job_NullableTime) Reset()         { *m = Job_NullableTime{} }
-func (m *Job_NullableTime) String() string { return proto.CompactTextString(m) }
-func (*Job_NullableTime) ProtoMessage()    {}
-func (*Job_NullableTime) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f956fb9152fd788f, []int{0, 3}
-}
-func (m *Job_NullableTime) XXX_Unmarshal(b []byte) error {
-	return m.Unmarshal(b)
-}
-func (m *Job_NullableTime) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	if deterministic {
-		return xxx_messageInfo_Job_NullableTime.Marshal(b, m, deterministic)
-	} else {
-		b = b[:cap(b)]
-		n, err := m.MarshalToSizedBuffer(b)
-		if err != nil {
-			return nil, err
-		}
-		return b[:n], nil
+func (x *Job) GetRetention() *RetentionPolicy {
+	if x != nil {
+		return x.Retention
 	}
-}
-func (m *Job_NullableTime) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Job_NullableTime.Merge(m, src)
-}
-func (m *Job_NullableTime) XXX_Size() int {
-	return m.Size()
-}
-func (m *Job_NullableTime) XXX_DiscardUnknown() {
-	xxx_messageInfo_Job_NullableTime.DiscardUnknown(m)
+	return nil
 }
 
-var xxx_messageInfo_Job_NullableTime proto.InternalMessageInfo
+func (x *Job) GetRetryPolicy() *RetryPolicy {
+	if x != nil {
+		return x.RetryPolicy
+	}
+	return nil
+}
 
-func (m *Job_NullableTime) GetHasValue() bool {
-	if m != nil {
-		return m.HasValue
+func (x *Job) GetRetryPlacement() string {
+	if x != nil {
+		return x.RetryPlacement
 	}
-	return false
+	return ""
 }
 
-func (m *Job_NullableTime) GetTime() *protobuf.Timestamp {
-	if m != nil {
-		return m.Time
+func (x *Job) GetMissedRunPolicy() *MissedRunPolicy {
+	if x != nil {
+		return x.MissedRunPolicy
 	}
 	return nil
 }
 
-type PluginConfig struct {
-	Config               map[string]string `protobuf:"bytes,1,rep,name=config,proto3" json:"config,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
-	XXX_unrecognized     []byte            `json:"-"`
-	XXX_sizecache        int32             `json:"-"`
+func (x *Job) GetCalendars() []string {
+	if x != nil {
+		return x.Calendars
+	}
+	return nil
 }
 
-func (m *PluginConfig) Reset()         { *m = PluginConfig{} }
-func (m *PluginConfig) String() string { return proto.CompactTextString(m) }
-func (*PluginConfig) ProtoMessage()    {}
-func (*PluginConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f956fb9152fd788f, []int{1}
-}
-func (m *PluginConfig) XXX_Unmarshal(b []byte) error {
-	return m.Unmarshal(b)
-}
-func (m *PluginConfig) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	if deterministic {
-		return xxx_messageInfo_PluginConfig.Marshal(b, m, deterministic)
-	} else {
-		b = b[:cap(b)]
-		n, err := m.MarshalToSizedBuffer(b)
-		if err != nil {
-			return nil, err
-		}
-		return b[:n], nil
+func (x *Job) GetAllowedWindows() []*TimeWindow {
+	if x != nil {
+		return x.AllowedWindows
 	}
+	return nil
 }
-func (m *PluginConfig) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_PluginConfig.Merge(m, src)
-}
-func (m *PluginConfig) XXX_Size() int {
-	return m.Size()
-}
-func (m *PluginConfig) XXX_DiscardUnknown() {
-	xxx_messageInfo_PluginConfig.DiscardUnknown(m)
-}
-
-var xxx_messageInfo_PluginConfig proto.InternalMessageInfo
 
-func (m *PluginConfig) GetConfig() map[string]string {
-	if m != nil {
-		return m.Config
+func (x *Job) GetParentJobs() []string {
+	if x != nil {
+		return x.ParentJobs
 	}
 	return nil
 }
 
-type SetJobRequest struct {
-	Job                  *Job     `protobuf:"bytes,1,opt,name=job,proto3" json:"job,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+func (x *Job) GetFanIn() string {
+	if x != nil {
+		return x.FanIn
+	}
+	return ""
 }
 
-func (m *SetJobRequest) Reset()         { *m = SetJobRequest{} }
-func (m *SetJobRequest) String() string { return proto.CompactTextString(m) }
-func (*SetJobRequest) ProtoMessage()    {}
-func (*SetJobRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f956fb9152fd788f, []int{2}
-}
-func (m *SetJobRequest) XXX_Unmarshal(b []byte) error {
-	return m.Unmarshal(b)
-}
-func (m *SetJobRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	if deterministic {
-		return xxx_messageInfo_SetJobRequest.Marshal(b, m, deterministic)
-	} else {
-		b = b[:cap(b)]
-		n, err := m.MarshalToSizedBuffer(b)
-		if err != nil {
-			return nil, err
-		}
-		return b[:n], nil
+func (x *Job) GetTriggerOn() string {
+	if x != nil {
+		return x.TriggerOn
 	}
+	return ""
 }
-func (m *SetJobRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_SetJobRequest.Merge(m, src)
-}
-func (m *SetJobRequest) XXX_Size() int {
-	return m.Size()
-}
-func (m *SetJobRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_SetJobRequest.DiscardUnknown(m)
-}
 
-var xxx_messageInfo_SetJobRequest proto.InternalMessageInfo
+type TimeWindow struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Days  []string `protobuf:"bytes,1,rep,name=days,proto3" json:"days,omitempty"`
+	Start string   `protobuf:"bytes,2,opt,name=start,proto3" json:"start,omitempty"`
+	End   string   `protobuf:"bytes,3,opt,name=end,proto3" json:"end,omitempty"`
+}
 
-func (m *SetJobRequest) GetJob() *Job {
-	if m != nil {
-		return m.Job
+func (x *TimeWindow) Reset() {
+	*x = TimeWindow{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_spiderjob_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return nil
 }
 
-type SetJobResponse struct {
-	Job                  *Job     `protobuf:"bytes,1,opt,name=job,proto3" json:"job,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+func (x *TimeWindow) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (m *SetJobResponse) Reset()         { *m = SetJobResponse{} }
-func (m *SetJobResponse) String() string { return proto.CompactTextString(m) }
-func (*SetJobResponse) ProtoMessage()    {}
-func (*SetJobResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f956fb9152fd788f, []int{3}
-}
-func (m *SetJobResponse) XXX_Unmarshal(b []byte) error {
-	return m.Unmarshal(b)
-}
-func (m *SetJobResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	if deterministic {
-		return xxx_messageInfo_SetJobResponse.Marshal(b, m, deterministic)
-	} else {
-		b = b[:cap(b)]
-		n, err := m.MarshalToSizedBuffer(b)
-		if err != nil {
-			return nil, err
+func (*TimeWindow) ProtoMessage() {}
+
+func (x *TimeWindow) ProtoReflect() protoreflect.Message {
+	mi := &file_spiderjob_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		return b[:n], nil
+		return ms
 	}
-}
-func (m *SetJobResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_SetJobResponse.Merge(m, src)
-}
-func (m *SetJobResponse) XXX_Size() int {
-	return m.Size()
-}
-func (m *SetJobResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_SetJobResponse.DiscardUnknown(m)
+	return mi.MessageOf(x)
 }
 
-var xxx_messageInfo_SetJobResponse proto.InternalMessageInfo
+// Deprecated: Use TimeWindow.ProtoReflect.Descriptor instead.
+func (*TimeWindow) Descriptor() ([]byte, []int) {
+	return file_spiderjob_proto_rawDescGZIP(), []int{1}
+}
 
-func (m *SetJobResponse) GetJob() *Job {
-	if m != nil {
-		return m.Job
+func (x *TimeWindow) GetDays() []string {
+	if x != nil {
+		return x.Days
 	}
 	return nil
 }
 
-type DeleteJobRequest struct {
-	JobName              string   `protobuf:"bytes,1,opt,name=job_name,json=jobName,proto3" json:"job_name,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
-}
-
-func (m *DeleteJobRequest) Reset()         { *m = DeleteJobRequest{} }
-func (m *DeleteJobRequest) String() string { return proto.CompactTextString(m) }
-func (*DeleteJobRequest) ProtoMessage()    {}
-func (*DeleteJobRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f956fb9152fd788f, []int{4}
-}
-func (m *DeleteJobRequest) XXX_Unmarshal(b []byte) error {
-	return m.Unmarshal(b)
-}
-func (m *DeleteJobRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	if deterministic {
-		return xxx_messageInfo_DeleteJobRequest.Marshal(b, m, deterministic)
-	} else {
-		b = b[:cap(b)]
-		n, err := m.MarshalToSizedBuffer(b)
-		if err != nil {
-			return nil, err
-		}
-		return b[:n], nil
+func (x *TimeWindow) GetStart() string {
+	if x != nil {
+		return x.Start
 	}
-}
-func (m *DeleteJobRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_DeleteJobRequest.Merge(m, src)
-}
-func (m *DeleteJobRequest) XXX_Size() int {
-	return m.Size()
-}
-func (m *DeleteJobRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_DeleteJobRequest.DiscardUnknown(m)
+	return ""
 }
 
-var xxx_messageInfo_DeleteJobRequest proto.InternalMessageInfo
-
-func (m *DeleteJobRequest) GetJobName() string {
-	if m != nil {
-		return m.JobName
+func (x *TimeWindow) GetEnd() string {
+	if x != nil {
+		return x.End
 	}
 	return ""
 }
 
-type DeleteJobResponse struct {
-	Job                  *Job     `protobuf:"bytes,1,opt,name=job,proto3" json:"job,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
-}
+type MissedRunPolicy struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-func (m *DeleteJobResponse) Reset()         { *m = DeleteJobResponse{} }
-func (m *DeleteJobResponse) String() string { return proto.CompactTextString(m) }
-func (*DeleteJobResponse) ProtoMessage()    {}
-func (*DeleteJobResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f956fb9152fd788f, []int{5}
-}
-func (m *DeleteJobResponse) XXX_Unmarshal(b []byte) error {
-	return m.Unmarshal(b)
-}
-func (m *DeleteJobResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	if deterministic {
-		return xxx_messageInfo_DeleteJobResponse.Marshal(b, m, deterministic)
-	} else {
-		b = b[:cap(b)]
-		n, err := m.MarshalToSizedBuffer(b)
-		if err != nil {
-			return nil, err
-		}
-		return b[:n], nil
-	}
-}
-func (m *DeleteJobResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_DeleteJobResponse.Merge(m, src)
-}
-func (m *DeleteJobResponse) XXX_Size() int {
-	return m.Size()
+	Mode        string `protobuf:"bytes,1,opt,name=mode,proto3" json:"mode,omitempty"`
+	Limit       int32  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	GraceWindow string `protobuf:"bytes,3,opt,name=grace_window,json=graceWindow,proto3" json:"grace_window,omitempty"`
 }
-func (m *DeleteJobResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_DeleteJobResponse.DiscardUnknown(m)
-}
-
-var xxx_messageInfo_DeleteJobResponse proto.InternalMessageInfo
 
-func (m *DeleteJobResponse) GetJob() *Job {
-	if m != nil {
-		return m.Job
+func (x *MissedRunPolicy) Reset() {
+	*x = MissedRunPolicy{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_spiderjob_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return nil
 }
 
-type GetJobRequest struct {
-	JobName              string   `protobuf:"bytes,1,opt,name=job_name,json=jobName,proto3" json:"job_name,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+func (x *MissedRunPolicy) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (m *GetJobRequest) Reset()         { *m = GetJobRequest{} }
-func (m *GetJobRequest) String() string { return proto.CompactTextString(m) }
-func (*GetJobRequest) ProtoMessage()    {}
-func (*GetJobRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f956fb9152fd788f, []int{6}
-}
-func (m *GetJobRequest) XXX_Unmarshal(b []byte) error {
-	return m.Unmarshal(b)
-}
-func (m *GetJobRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	if deterministic {
-		return xxx_messageInfo_GetJobRequest.Marshal(b, m, deterministic)
-	} else {
-		b = b[:cap(b)]
-		n, err := m.MarshalToSizedBuffer(b)
-		if err != nil {
-			return nil, err
+func (*MissedRunPolicy) ProtoMessage() {}
+
+func (x *MissedRunPolicy) ProtoReflect() protoreflect.Message {
+	mi := &file_spiderjob_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		return b[:n], nil
+		return ms
 	}
-}
-func (m *GetJobRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetJobRequest.Merge(m, src)
-}
-func (m *GetJobRequest) XXX_Size() int {
-	return m.Size()
-}
-func (m *GetJobRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetJobRequest.DiscardUnknown(m)
+	return mi.MessageOf(x)
 }
 
-var xxx_messageInfo_GetJobRequest proto.InternalMessageInfo
+// Deprecated: Use MissedRunPolicy.ProtoReflect.Descriptor instead.
+func (*MissedRunPolicy) Descriptor() ([]byte, []int) {
+	return file_spiderjob_proto_rawDescGZIP(), []int{2}
+}
 
-func (m *GetJobRequest) GetJobName() string {
-	if m != nil {
-		return m.JobName
+func (x *MissedRunPolicy) GetMode() string {
+	if x != nil {
+		return x.Mode
 	}
 	return ""
 }
 
-type GetJobResponse struct {
-	Job                  *Job     `protobuf:"bytes,1,opt,name=job,proto3" json:"job,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+func (x *MissedRunPolicy) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
 }
 
-func (m *GetJobResponse) Reset()         { *m = GetJobResponse{} }
-func (m *GetJobResponse) String() string { return proto.CompactTextString(m) }
-func (*GetJobResponse) ProtoMessage()    {}
-func (*GetJobResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f956fb9152fd788f, []int{7}
-}
-func (m *GetJobResponse) XXX_Unmarshal(b []byte) error {
-	return m.Unmarshal(b)
-}
-func (m *GetJobResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	if deterministic {
-		return xxx_messageInfo_GetJobResponse.Marshal(b, m, deterministic)
-	} else {
-		b = b[:cap(b)]
-		n, err := m.MarshalToSizedBuffer(b)
-		if err != nil {
-			return nil, err
-		}
-		return b[:n], nil
+func (x *MissedRunPolicy) GetGraceWindow() string {
+	if x != nil {
+		return x.GraceWindow
 	}
-}
-func (m *GetJobResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetJobResponse.Merge(m, src)
-}
-func (m *GetJobResponse) XXX_Size() int {
-	return m.Size()
-}
-func (m *GetJobResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetJobResponse.DiscardUnknown(m)
+	return ""
 }
 
-var xxx_messageInfo_GetJobResponse proto.InternalMessageInfo
+type RetryPolicy struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-func (m *GetJobResponse) GetJob() *Job {
-	if m != nil {
-		return m.Job
-	}
-	return nil
+	Backoff  string   `protobuf:"bytes,1,opt,name=backoff,proto3" json:"backoff,omitempty"`
+	Delay    string   `protobuf:"bytes,2,opt,name=delay,proto3" json:"delay,omitempty"`
+	MaxDelay string   `protobuf:"bytes,3,opt,name=max_delay,json=maxDelay,proto3" json:"max_delay,omitempty"`
+	Jitter   float64  `protobuf:"fixed64,4,opt,name=jitter,proto3" json:"jitter,omitempty"`
+	RetryOn  []string `protobuf:"bytes,5,rep,name=retry_on,json=retryOn,proto3" json:"retry_on,omitempty"`
 }
 
-type Execution struct {
-	JobName              string              `protobuf:"bytes,1,opt,name=job_name,json=jobName,proto3" json:"job_name,omitempty"`
-	Success              bool                `protobuf:"varint,2,opt,name=success,proto3" json:"success,omitempty"`
-	Output               []byte              `protobuf:"bytes,3,opt,name=output,proto3" json:"output,omitempty"`
-	NodeName             string              `protobuf:"bytes,4,opt,name=node_name,json=nodeName,proto3" json:"node_name,omitempty"`
-	Group                int64               `protobuf:"varint,5,opt,name=group,proto3" json:"group,omitempty"`
-	Attempt              uint32              `protobuf:"varint,6,opt,name=attempt,proto3" json:"attempt,omitempty"`
-	StartedAt            *protobuf.Timestamp `protobuf:"bytes,7,opt,name=started_at,json=startedAt,proto3" json:"started_at,omitempty"`
-	FinishedAt           *protobuf.Timestamp `protobuf:"bytes,8,opt,name=finished_at,json=finishedAt,proto3" json:"finished_at,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
-	XXX_unrecognized     []byte              `json:"-"`
-	XXX_sizecache        int32               `json:"-"`
-}
-
-func (m *Execution) Reset()         { *m = Execution{} }
-func (m *Execution) String() string { return proto.CompactTextString(m) }
-func (*Execution) ProtoMessage()    {}
-func (*Execution) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f956fb9152fd788f, []int{8}
-}
-func (m *Execution) XXX_Unmarshal(b []byte) error {
-	return m.Unmarshal(b)
-}
-func (m *Execution) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	if deterministic {
-		return xxx_messageInfo_Execution.Marshal(b, m, deterministic)
-	} else {
-		b = b[:cap(b)]
-		n, err := m.MarshalToSizedBuffer(b)
-		if err != nil {
-			return nil, err
-		}
-		return b[:n], nil
+func (x *RetryPolicy) Reset() {
+	*x = RetryPolicy{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_spiderjob_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
 }
-func (m *Execution) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Execution.Merge(m, src)
-}
-func (m *Execution) XXX_Size() int {
-	return m.Size()
-}
-func (m *Execution) XXX_DiscardUnknown() {
-	xxx_messageInfo_Execution.DiscardUnknown(m)
+
+func (x *RetryPolicy) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-var xxx_messageInfo_Execution proto.InternalMessageInfo
+func (*RetryPolicy) ProtoMessage() {}
 
-func (m *Execution) GetJobName() string {
-	if m != nil {
-		return m.JobName
+func (x *RetryPolicy) ProtoReflect() protoreflect.Message {
+	mi := &file_spiderjob_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (m *Execution) GetSuccess() bool {
-	if m != nil {
-		return m.Success
-	}
-	return false
+// Deprecated: Use RetryPolicy.ProtoReflect.Descriptor instead.
+func (*RetryPolicy) Descriptor() ([]byte, []int) {
+	return file_spiderjob_proto_rawDescGZIP(), []int{3}
 }
 
-func (m *Execution) GetOutput() []byte {
-	if m != nil {
-		return m.Output
+func (x *RetryPolicy) GetBackoff() string {
+	if x != nil {
+		return x.Backoff
 	}
-	return nil
+	return ""
 }
 
-func (m *Execution) GetNodeName() string {
-	if m != nil {
-		return m.NodeName
+func (x *RetryPolicy) GetDelay() string {
+	if x != nil {
+		return x.Delay
 	}
 	return ""
 }
 
-func (m *Execution) GetGroup() int64 {
-	if m != nil {
-		return m.Group
+func (x *RetryPolicy) GetMaxDelay() string {
+	if x != nil {
+		return x.MaxDelay
 	}
-	return 0
+	return ""
 }
 
-func (m *Execution) GetAttempt() uint32 {
-	if m != nil {
-		return m.Attempt
+func (x *RetryPolicy) GetJitter() float64 {
+	if x != nil {
+		return x.Jitter
 	}
 	return 0
 }
 
-func (m *Execution) GetStartedAt() *protobuf.Timestamp {
-	if m != nil {
-		return m.StartedAt
+func (x *RetryPolicy) GetRetryOn() []string {
+	if x != nil {
+		return x.RetryOn
 	}
 	return nil
 }
 
-func (m *Execution) GetFinishedAt() *protobuf.Timestamp {
-	if m != nil {
-		return m.FinishedAt
-	}
-	return nil
-}
+type RetentionPolicy struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-type ExecutionDoneRequest struct {
-	Execution            *Execution `protobuf:"bytes,1,opt,name=execution,proto3" json:"execution,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
-	XXX_unrecognized     []byte     `json:"-"`
-	XXX_sizecache        int32      `json:"-"`
+	MaxExecutions   int32  `protobuf:"varint,1,opt,name=max_executions,json=maxExecutions,proto3" json:"max_executions,omitempty"`
+	MaxAge          string `protobuf:"bytes,2,opt,name=max_age,json=maxAge,proto3" json:"max_age,omitempty"`
+	KeepLastFailure bool   `protobuf:"varint,3,opt,name=keep_last_failure,json=keepLastFailure,proto3" json:"keep_last_failure,omitempty"`
+	KeepLastSuccess bool   `protobuf:"varint,4,opt,name=keep_last_success,json=keepLastSuccess,proto3" json:"keep_last_success,omitempty"`
 }
 
-func (m *ExecutionDoneRequest) Reset()         { *m = ExecutionDoneRequest{} }
-func (m *ExecutionDoneRequest) String() string { return proto.CompactTextString(m) }
-func (*ExecutionDoneRequest) ProtoMessage()    {}
-func (*ExecutionDoneRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f956fb9152fd788f, []int{9}
-}
-func (m *ExecutionDoneRequest) XXX_Unmarshal(b []byte) error {
-	return m.Unmarshal(b)
-}
-func (m *ExecutionDoneRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	if deterministic {
-		return xxx_messageInfo_ExecutionDoneRequest.Marshal(b, m, deterministic)
-	} else {
-		b = b[:cap(b)]
-		n, err := m.MarshalToSizedBuffer(b)
-		if err != nil {
-			return nil, err
-		}
-		return b[:n], nil
+func (x *RetentionPolicy) Reset() {
+	*x = RetentionPolicy{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_spiderjob_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
 }
-func (m *ExecutionDoneRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ExecutionDoneRequest.Merge(m, src)
-}
-func (m *ExecutionDoneRequest) XXX_Size() int {
-	return m.Size()
-}
-func (m *ExecutionDoneRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_ExecutionDoneRequest.DiscardUnknown(m)
+
+func (x *RetentionPolicy) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-var xxx_messageInfo_ExecutionDoneRequest proto.InternalMessageInfo
+func (*RetentionPolicy) ProtoMessage() {}
 
-func (m *ExecutionDoneRequest) GetExecution() *Execution {
-	if m != nil {
-		return m.Execution
+func (x *RetentionPolicy) ProtoReflect() protoreflect.Message {
+	mi := &file_spiderjob_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-type ExecutionDoneResponse struct {
-	From                 string   `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
-	Payload              []byte   `protobuf:"bytes,2,opt,name=payload,proto3" json:"payload,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+// Deprecated: Use RetentionPolicy.ProtoReflect.Descriptor instead.
+func (*RetentionPolicy) Descriptor() ([]byte, []int) {
+	return file_spiderjob_proto_rawDescGZIP(), []int{4}
 }
 
-func (m *ExecutionDoneResponse) Reset()         { *m = ExecutionDoneResponse{} }
-func (m *ExecutionDoneResponse) String() string { return proto.CompactTextString(m) }
-func (*ExecutionDoneResponse) ProtoMessage()    {}
-func (*ExecutionDoneResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f956fb9152fd788f, []int{10}
-}
-func (m *ExecutionDoneResponse) XXX_Unmarshal(b []byte) error {
-	return m.Unmarshal(b)
-}
-func (m *ExecutionDoneResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	if deterministic {
-		return xxx_messageInfo_ExecutionDoneResponse.Marshal(b, m, deterministic)
-	} else {
-		b = b[:cap(b)]
-		n, err := m.MarshalToSizedBuffer(b)
-		if err != nil {
-			return nil, err
-		}
-		return b[:n], nil
+func (x *RetentionPolicy) GetMaxExecutions() int32 {
+	if x != nil {
+		return x.MaxExecutions
 	}
+	return 0
 }
-func (m *ExecutionDoneResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ExecutionDoneResponse.Merge(m, src)
-}
-func (m *ExecutionDoneResponse) XXX_Size() int {
-	return m.Size()
-}
-func (m *ExecutionDoneResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_ExecutionDoneResponse.DiscardUnknown(m)
-}
-
-var xxx_messageInfo_ExecutionDoneResponse proto.InternalMessageInfo
 
-func (m *ExecutionDoneResponse) GetFrom() string {
-	if m != nil {
-		return m.From
+func (x *RetentionPolicy) GetMaxAge() string {
+	if x != nil {
+		return x.MaxAge
 	}
 	return ""
 }
 
-func (m *ExecutionDoneResponse) GetPayload() []byte {
-	if m != nil {
-		return m.Payload
+func (x *RetentionPolicy) GetKeepLastFailure() bool {
+	if x != nil {
+		return x.KeepLastFailure
 	}
-	return nil
-}
-
-type RunJobRequest struct {
-	JobName              string   `protobuf:"bytes,1,opt,name=job_name,json=jobName,proto3" json:"job_name,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	return false
 }
 
-func (m *RunJobRequest) Reset()         { *m = RunJobRequest{} }
-func (m *RunJobRequest) String() string { return proto.CompactTextString(m) }
-func (*RunJobRequest) ProtoMessage()    {}
-func (*RunJobRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f956fb9152fd788f, []int{11}
-}
-func (m *RunJobRequest) XXX_Unmarshal(b []byte) error {
-	return m.Unmarshal(b)
-}
-func (m *RunJobRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	if deterministic {
-		return xxx_messageInfo_RunJobRequest.Marshal(b, m, deterministic)
-	} else {
-		b = b[:cap(b)]
-		n, err := m.MarshalToSizedBuffer(b)
-		if err != nil {
-			return nil, err
-		}
-		return b[:n], nil
+func (x *RetentionPolicy) GetKeepLastSuccess() bool {
+	if x != nil {
+		return x.KeepLastSuccess
 	}
+	return false
 }
-func (m *RunJobRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_RunJobRequest.Merge(m, src)
-}
-func (m *RunJobRequest) XXX_Size() int {
-	return m.Size()
-}
-func (m *RunJobRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_RunJobRequest.DiscardUnknown(m)
-}
 
-var xxx_messageInfo_RunJobRequest proto.InternalMessageInfo
+type PluginConfig struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Config map[string]string `protobuf:"bytes,1,rep,name=config,proto3" json:"config,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
 
-func (m *RunJobRequest) GetJobName() string {
-	if m != nil {
-		return m.JobName
+func (x *PluginConfig) Reset() {
+	*x = PluginConfig{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_spiderjob_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return ""
 }
 
-type RunJobResponse struct {
-	Job                  *Job     `protobuf:"bytes,1,opt,name=job,proto3" json:"job,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+func (x *PluginConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (m *RunJobResponse) Reset()         { *m = RunJobResponse{} }
-func (m *RunJobResponse) String() string { return proto.CompactTextString(m) }
-func (*RunJobResponse) ProtoMessage()    {}
-func (*RunJobResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f956fb9152fd788f, []int{12}
-}
-func (m *RunJobResponse) XXX_Unmarshal(b []byte) error {
-	return m.Unmarshal(b)
-}
-func (m *RunJobResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	if deterministic {
-		return xxx_messageInfo_RunJobResponse.Marshal(b, m, deterministic)
-	} else {
-		b = b[:cap(b)]
-		n, err := m.MarshalToSizedBuffer(b)
-		if err != nil {
-			return nil, err
+func (*PluginConfig) ProtoMessage() {}
+
+func (x *PluginConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_spiderjob_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		return b[:n], nil
+		return ms
 	}
-}
-func (m *RunJobResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_RunJobResponse.Merge(m, src)
-}
-func (m *RunJobResponse) XXX_Size() int {
-	return m.Size()
-}
-func (m *RunJobResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_RunJobResponse.DiscardUnknown(m)
+	return mi.MessageOf(x)
 }
 
-var xxx_messageInfo_RunJobResponse proto.InternalMessageInfo
+// Deprecated: Use PluginConfig.ProtoReflect.Descriptor instead.
+func (*PluginConfig) Descriptor() ([]byte, []int) {
+	return file_spiderjob_proto_rawDescGZIP(), []int{5}
+}
 
-func (m *RunJobResponse) GetJob() *Job {
-	if m != nil {
-		return m.Job
+func (x *PluginConfig) GetConfig() map[string]string {
+	if x != nil {
+		return x.Config
 	}
 	return nil
 }
 
-type ToggleJobRequest struct {
-	JobName              string   `protobuf:"bytes,1,opt,name=job_name,json=jobName,proto3" json:"job_name,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+type SetJobRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Job *Job `protobuf:"bytes,1,opt,name=job,proto3" json:"job,omitempty"`
 }
 
-func (m *ToggleJobRequest) Reset()         { *m = ToggleJobRequest{} }
-func (m *ToggleJobRequest) String() string { return proto.CompactTextString(m) }
-func (*ToggleJobRequest) ProtoMessage()    {}
-func (*ToggleJobRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f956fb9152fd788f, []int{13}
-}
-func (m *ToggleJobRequest) XXX_Unmarshal(b []byte) error {
-	return m.Unmarshal(b)
-}
-func (m *ToggleJobRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	if deterministic {
-		return xxx_messageInfo_ToggleJobRequest.Marshal(b, m, deterministic)
-	} else {
-		b = b[:cap(b)]
-		n, err := m.MarshalToSizedBuffer(b)
-		if err != nil {
-			return nil, err
-		}
-		return b[:n], nil
+func (x *SetJobRequest) Reset() {
+	*x = SetJobRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_spiderjob_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
 }
-func (m *ToggleJobRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ToggleJobRequest.Merge(m, src)
-}
-func (m *ToggleJobRequest) XXX_Size() int {
-	return m.Size()
-}
-func (m *ToggleJobRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_ToggleJobRequest.DiscardUnknown(m)
+
+func (x *SetJobRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-var xxx_messageInfo_ToggleJobRequest proto.InternalMessageInfo
+func (*SetJobRequest) ProtoMessage() {}
 
-func (m *ToggleJobRequest) GetJobName() string {
-	if m != nil {
-		return m.JobName
+func (x *SetJobRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_spiderjob_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-type ToggleJobResponse struct {
-	Job                  *Job     `protobuf:"bytes,1,opt,name=job,proto3" json:"job,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+// Deprecated: Use SetJobRequest.ProtoReflect.Descriptor instead.
+func (*SetJobRequest) Descriptor() ([]byte, []int) {
+	return file_spiderjob_proto_rawDescGZIP(), []int{6}
 }
 
-func (m *ToggleJobResponse) Reset()         { *m = ToggleJobResponse{} }
-func (m *ToggleJobResponse) String() string { return proto.CompactTextString(m) }
-func (*ToggleJobResponse) ProtoMessage()    {}
-func (*ToggleJobResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f956fb9152fd788f, []int{14}
-}
-func (m *ToggleJobResponse) XXX_Unmarshal(b []byte) error {
-	return m.Unmarshal(b)
-}
-func (m *ToggleJobResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	if deterministic {
-		return xxx_messageInfo_ToggleJobResponse.Marshal(b, m, deterministic)
-	} else {
-		b = b[:cap(b)]
-		n, err := m.MarshalToSizedBuffer(b)
-		if err != nil {
-			return nil, err
-		}
-		return b[:n], nil
+func (x *SetJobRequest) GetJob() *Job {
+	if x != nil {
+		return x.Job
 	}
-}
-func (m *ToggleJobResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ToggleJobResponse.Merge(m, src)
-}
-func (m *ToggleJobResponse) XXX_Size() int {
-	return m.Size()
-}
-func (m *ToggleJobResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_ToggleJobResponse.DiscardUnknown(m)
+	return nil
 }
 
-var xxx_messageInfo_ToggleJobResponse proto.InternalMessageInfo
+type SetJobResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-func (m *ToggleJobResponse) GetJob() *Job {
-	if m != nil {
-		return m.Job
-	}
-	return nil
+	Job *Job `protobuf:"bytes,1,opt,name=job,proto3" json:"job,omitempty"`
 }
 
-type RaftServer struct {
-	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Node                 string   `protobuf:"bytes,2,opt,name=node,proto3" json:"node,omitempty"`
-	Address              string   `protobuf:"bytes,3,opt,name=address,proto3" json:"address,omitempty"`
-	Leader               bool     `protobuf:"varint,4,opt,name=leader,proto3" json:"leader,omitempty"`
-	Voter                bool     `protobuf:"varint,5,opt,name=voter,proto3" json:"voter,omitempty"`
-	RaftProtocol         string   `protobuf:"bytes,6,opt,name=raft_protocol,json=raftProtocol,proto3" json:"raft_protocol,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
-}
-
-func (m *RaftServer) Reset()         { *m = RaftServer{} }
-func (m *RaftServer) String() string { return proto.CompactTextString(m) }
-func (*RaftServer) ProtoMessage()    {}
-func (*RaftServer) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f956fb9152fd788f, []int{15}
-}
-func (m *RaftServer) XXX_Unmarshal(b []byte) error {
-	return m.Unmarshal(b)
-}
-func (m *RaftServer) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	if deterministic {
-		return xxx_messageInfo_RaftServer.Marshal(b, m, deterministic)
-	} else {
-		b = b[:cap(b)]
-		n, err := m.MarshalToSizedBuffer(b)
-		if err != nil {
-			return nil, err
-		}
-		return b[:n], nil
+func (x *SetJobResponse) Reset() {
+	*x = SetJobResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_spiderjob_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
 }
-func (m *RaftServer) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_RaftServer.Merge(m, src)
-}
-func (m *RaftServer) XXX_Size() int {
-	return m.Size()
-}
-func (m *RaftServer) XXX_DiscardUnknown() {
-	xxx_messageInfo_RaftServer.DiscardUnknown(m)
+
+func (x *SetJobResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-var xxx_messageInfo_RaftServer proto.InternalMessageInfo
+func (*SetJobResponse) ProtoMessage() {}
 
-func (m *RaftServer) GetId() string {
-	if m != nil {
-		return m.Id
+func (x *SetJobResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_spiderjob_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (m *RaftServer) GetNode() string {
-	if m != nil {
-		return m.Node
-	}
-	return ""
+// Deprecated: Use SetJobResponse.ProtoReflect.Descriptor instead.
+func (*SetJobResponse) Descriptor() ([]byte, []int) {
+	return file_spiderjob_proto_rawDescGZIP(), []int{7}
 }
 
-func (m *RaftServer) GetAddress() string {
-	if m != nil {
-		return m.Address
+func (x *SetJobResponse) GetJob() *Job {
+	if x != nil {
+		return x.Job
 	}
-	return ""
+	return nil
 }
 
-func (m *RaftServer) GetLeader() bool {
-	if m != nil {
-		return m.Leader
-	}
-	return false
-}
+type DeleteJobRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-func (m *RaftServer) GetVoter() bool {
-	if m != nil {
-		return m.Voter
-	}
-	return false
+	JobName string `protobuf:"bytes,1,opt,name=job_name,json=jobName,proto3" json:"job_name,omitempty"`
 }
 
-func (m *RaftServer) GetRaftProtocol() string {
-	if m != nil {
-		return m.RaftProtocol
+func (x *DeleteJobRequest) Reset() {
+	*x = DeleteJobRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_spiderjob_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return ""
 }
 
-type RaftGetConfigurationResponse struct {
-	Servers              []*RaftServer `protobuf:"bytes,1,rep,name=servers,proto3" json:"servers,omitempty"`
-	Index                uint64        `protobuf:"varint,2,opt,name=index,proto3" json:"index,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
-	XXX_unrecognized     []byte        `json:"-"`
-	XXX_sizecache        int32         `json:"-"`
+func (x *DeleteJobRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (m *RaftGetConfigurationResponse) Reset()         { *m = RaftGetConfigurationResponse{} }
-func (m *RaftGetConfigurationResponse) String() string { return proto.CompactTextString(m) }
-func (*RaftGetConfigurationResponse) ProtoMessage()    {}
-func (*RaftGetConfigurationResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f956fb9152fd788f, []int{16}
-}
-func (m *RaftGetConfigurationResponse) XXX_Unmarshal(b []byte) error {
-	return m.Unmarshal(b)
-}
-func (m *RaftGetConfigurationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	if deterministic {
-		return xxx_messageInfo_RaftGetConfigurationResponse.Marshal(b, m, deterministic)
-	} else {
-		b = b[:cap(b)]
-		n, err := m.MarshalToSizedBuffer(b)
-		if err != nil {
-			return nil, err
+func (*DeleteJobRequest) ProtoMessage() {}
+
+func (x *DeleteJobRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_spiderjob_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		return b[:n], nil
+		return ms
 	}
+	return mi.MessageOf(x)
 }
-func (m *RaftGetConfigurationResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_RaftGetConfigurationResponse.Merge(m, src)
-}
-func (m *RaftGetConfigurationResponse) XXX_Size() int {
-	return m.Size()
+
+// Deprecated: Use DeleteJobRequest.ProtoReflect.Descriptor instead.
+func (*DeleteJobRequest) Descriptor() ([]byte, []int) {
+	return file_spiderjob_proto_rawDescGZIP(), []int{8}
 }
-func (m *RaftGetConfigurationResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_RaftGetConfigurationResponse.DiscardUnknown(m)
+
+func (x *DeleteJobRequest) GetJobName() string {
+	if x != nil {
+		return x.JobName
+	}
+	return ""
 }
 
-var xxx_messageInfo_RaftGetConfigurationResponse proto.InternalMessageInfo
+type DeleteJobResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-func (m *RaftGetConfigurationResponse) GetServers() []*RaftServer {
-	if m != nil {
-		return m.Servers
-	}
-	return nil
+	Job *Job `protobuf:"bytes,1,opt,name=job,proto3" json:"job,omitempty"`
 }
 
-func (m *RaftGetConfigurationResponse) GetIndex() uint64 {
-	if m != nil {
-		return m.Index
+func (x *DeleteJobResponse) Reset() {
+	*x = DeleteJobResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_spiderjob_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return 0
 }
 
-type RaftRemovePeerByIDRequest struct {
-	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+func (x *DeleteJobResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (m *RaftRemovePeerByIDRequest) Reset()         { *m = RaftRemovePeerByIDRequest{} }
-func (m *RaftRemovePeerByIDRequest) String() string { return proto.CompactTextString(m) }
-func (*RaftRemovePeerByIDRequest) ProtoMessage()    {}
-func (*RaftRemovePeerByIDRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f956fb9152fd788f, []int{17}
-}
-func (m *RaftRemovePeerByIDRequest) XXX_Unmarshal(b []byte) error {
-	return m.Unmarshal(b)
-}
-func (m *RaftRemovePeerByIDRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	if deterministic {
-		return xxx_messageInfo_RaftRemovePeerByIDRequest.Marshal(b, m, deterministic)
-	} else {
-		b = b[:cap(b)]
-		n, err := m.MarshalToSizedBuffer(b)
-		if err != nil {
-			return nil, err
+func (*DeleteJobResponse) ProtoMessage() {}
+
+func (x *DeleteJobResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_spiderjob_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		return b[:n], nil
+		return ms
 	}
-}
-func (m *RaftRemovePeerByIDRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_RaftRemovePeerByIDRequest.Merge(m, src)
-}
-func (m *RaftRemovePeerByIDRequest) XXX_Size() int {
-	return m.Size()
-}
-func (m *RaftRemovePeerByIDRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_RaftRemovePeerByIDRequest.DiscardUnknown(m)
+	return mi.MessageOf(x)
 }
 
-var xxx_messageInfo_RaftRemovePeerByIDRequest proto.InternalMessageInfo
+// Deprecated: Use DeleteJobResponse.ProtoReflect.Descriptor instead.
+func (*DeleteJobResponse) Descriptor() ([]byte, []int) {
+	return file_spiderjob_proto_rawDescGZIP(), []int{9}
+}
 
-func (m *RaftRemovePeerByIDRequest) GetId() string {
-	if m != nil {
-		return m.Id
+func (x *DeleteJobResponse) GetJob() *Job {
+	if x != nil {
+		return x.Job
 	}
-	return ""
+	return nil
 }
 
-type AgentRunStream struct {
-	Execution            *Execution `protobuf:"bytes,1,opt,name=execution,proto3" json:"execution,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
-	XXX_unrecognized     []byte     `json:"-"`
-	XXX_sizecache        int32      `json:"-"`
+type GetJobRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	JobName string `protobuf:"bytes,1,opt,name=job_name,json=jobName,proto3" json:"job_name,omitempty"`
 }
 
-func (m *AgentRunStream) Reset()         { *m = AgentRunStream{} }
-func (m *AgentRunStream) String() string { return proto.CompactTextString(m) }
-func (*AgentRunStream) ProtoMessage()    {}
-func (*AgentRunStream) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f956fb9152fd788f, []int{18}
-}
-func (m *AgentRunStream) XXX_Unmarshal(b []byte) error {
-	return m.Unmarshal(b)
-}
-func (m *AgentRunStream) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	if deterministic {
-		return xxx_messageInfo_AgentRunStream.Marshal(b, m, deterministic)
-	} else {
-		b = b[:cap(b)]
-		n, err := m.MarshalToSizedBuffer(b)
-		if err != nil {
-			return nil, err
-		}
-		return b[:n], nil
+func (x *GetJobRequest) Reset() {
+	*x = GetJobRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_spiderjob_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
 }
-func (m *AgentRunStream) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_AgentRunStream.Merge(m, src)
-}
-func (m *AgentRunStream) XXX_Size() int {
-	return m.Size()
-}
-func (m *AgentRunStream) XXX_DiscardUnknown() {
-	xxx_messageInfo_AgentRunStream.DiscardUnknown(m)
+
+func (x *GetJobRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-var xxx_messageInfo_AgentRunStream proto.InternalMessageInfo
+func (*GetJobRequest) ProtoMessage() {}
 
-func (m *AgentRunStream) GetExecution() *Execution {
-	if m != nil {
-		return m.Execution
+func (x *GetJobRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_spiderjob_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-type AgentRunResponse struct {
-	From                 string   `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
-	Payload              []byte   `protobuf:"bytes,2,opt,name=payload,proto3" json:"payload,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+// Deprecated: Use GetJobRequest.ProtoReflect.Descriptor instead.
+func (*GetJobRequest) Descriptor() ([]byte, []int) {
+	return file_spiderjob_proto_rawDescGZIP(), []int{10}
 }
 
-func (m *AgentRunResponse) Reset()         { *m = AgentRunResponse{} }
-func (m *AgentRunResponse) String() string { return proto.CompactTextString(m) }
-func (*AgentRunResponse) ProtoMessage()    {}
-func (*AgentRunResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f956fb9152fd788f, []int{19}
-}
-func (m *AgentRunResponse) XXX_Unmarshal(b []byte) error {
-	return m.Unmarshal(b)
-}
-func (m *AgentRunResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	if deterministic {
-		return xxx_messageInfo_AgentRunResponse.Marshal(b, m, deterministic)
-	} else {
-		b = b[:cap(b)]
-		n, err := m.MarshalToSizedBuffer(b)
-		if err != nil {
-			return nil, err
-		}
-		return b[:n], nil
+func (x *GetJobRequest) GetJobName() string {
+	if x != nil {
+		return x.JobName
 	}
-}
-func (m *AgentRunResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_AgentRunResponse.Merge(m, src)
-}
-func (m *AgentRunResponse) XXX_Size() int {
-	return m.Size()
-}
-func (m *AgentRunResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_AgentRunResponse.DiscardUnknown(m)
+	return ""
 }
 
-var xxx_messageInfo_AgentRunResponse proto.InternalMessageInfo
+type GetJobResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-func (m *AgentRunResponse) GetFrom() string {
-	if m != nil {
-		return m.From
-	}
-	return ""
+	Job *Job `protobuf:"bytes,1,opt,name=job,proto3" json:"job,omitempty"`
 }
 
-func (m *AgentRunResponse) GetPayload() []byte {
-	if m != nil {
-		return m.Payload
+func (x *GetJobResponse) Reset() {
+	*x = GetJobResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_spiderjob_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return nil
 }
 
-type GetActiveExecutionsResponse struct {
-	Executions           []*Execution `protobuf:"bytes,1,rep,name=executions,proto3" json:"executions,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
-	XXX_unrecognized     []byte       `json:"-"`
-	XXX_sizecache        int32        `json:"-"`
+func (x *GetJobResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (m *GetActiveExecutionsResponse) Reset()         { *m = GetActiveExecutionsResponse{} }
-func (m *GetActiveExecutionsResponse) String() string { return proto.CompactTextString(m) }
-func (*GetActiveExecutionsResponse) ProtoMessage()    {}
-func (*GetActiveExecutionsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f956fb9152fd788f, []int{20}
-}
-func (m *GetActiveExecutionsResponse) XXX_Unmarshal(b []byte) error {
-	return m.Unmarshal(b)
-}
-func (m *GetActiveExecutionsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	if deterministic {
-		return xxx_messageInfo_GetActiveExecutionsResponse.Marshal(b, m, deterministic)
-	} else {
-		b = b[:cap(b)]
-		n, err := m.MarshalToSizedBuffer(b)
-		if err != nil {
-			return nil, err
+func (*GetJobResponse) ProtoMessage() {}
+
+func (x *GetJobResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_spiderjob_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		return b[:n], nil
+		return ms
 	}
-}
-func (m *GetActiveExecutionsResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetActiveExecutionsResponse.Merge(m, src)
-}
-func (m *GetActiveExecutionsResponse) XXX_Size() int {
-	return m.Size()
-}
-func (m *GetActiveExecutionsResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetActiveExecutionsResponse.DiscardUnknown(m)
+	return mi.MessageOf(x)
 }
 
-var xxx_messageInfo_GetActiveExecutionsResponse proto.InternalMessageInfo
+// Deprecated: Use GetJobResponse.ProtoReflect.Descriptor instead.
+func (*GetJobResponse) Descriptor() ([]byte, []int) {
+	return file_spiderjob_proto_rawDescGZIP(), []int{11}
+}
 
-func (m *GetActiveExecutionsResponse) GetExecutions() []*Execution {
-	if m != nil {
-		return m.Executions
+func (x *GetJobResponse) GetJob() *Job {
+	if x != nil {
+		return x.Job
 	}
 	return nil
 }
 
-type AgentRunRequest struct {
-	Job                  *Job       `protobuf:"bytes,1,opt,name=job,proto3" json:"job,omitempty"`
-	Execution            *Execution `protobuf:"bytes,2,opt,name=execution,proto3" json:"execution,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
-	XXX_unrecognized     []byte     `json:"-"`
-	XXX_sizecache        int32      `json:"-"`
+type Execution struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	JobName         string                 `protobuf:"bytes,1,opt,name=job_name,json=jobName,proto3" json:"job_name,omitempty"`
+	Success         bool                   `protobuf:"varint,2,opt,name=success,proto3" json:"success,omitempty"`
+	Output          []byte                 `protobuf:"bytes,3,opt,name=output,proto3" json:"output,omitempty"`
+	NodeName        string                 `protobuf:"bytes,4,opt,name=node_name,json=nodeName,proto3" json:"node_name,omitempty"`
+	Group           int64                  `protobuf:"varint,5,opt,name=group,proto3" json:"group,omitempty"`
+	Attempt         uint32                 `protobuf:"varint,6,opt,name=attempt,proto3" json:"attempt,omitempty"`
+	StartedAt       *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=started_at,json=startedAt,proto3" json:"started_at,omitempty"`
+	FinishedAt      *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=finished_at,json=finishedAt,proto3" json:"finished_at,omitempty"`
+	TimedOut        bool                   `protobuf:"varint,9,opt,name=timed_out,json=timedOut,proto3" json:"timed_out,omitempty"`
+	Cancelled       bool                   `protobuf:"varint,10,opt,name=cancelled,proto3" json:"cancelled,omitempty"`
+	CancelledBy     string                 `protobuf:"bytes,11,opt,name=cancelled_by,json=cancelledBy,proto3" json:"cancelled_by,omitempty"`
+	ExitCode        int32                  `protobuf:"varint,12,opt,name=exit_code,json=exitCode,proto3" json:"exit_code,omitempty"`
+	Stdout          []byte                 `protobuf:"bytes,13,opt,name=stdout,proto3" json:"stdout,omitempty"`
+	Stderr          []byte                 `protobuf:"bytes,14,opt,name=stderr,proto3" json:"stderr,omitempty"`
+	FailureCategory string                 `protobuf:"bytes,15,opt,name=failure_category,json=failureCategory,proto3" json:"failure_category,omitempty"`
+	Results         map[string]string      `protobuf:"bytes,16,rep,name=results,proto3" json:"results,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Skipped         bool                   `protobuf:"varint,17,opt,name=skipped,proto3" json:"skipped,omitempty"`
+	SkipReason      string                 `protobuf:"bytes,18,opt,name=skip_reason,json=skipReason,proto3" json:"skip_reason,omitempty"`
+	TriggeredBy     string                 `protobuf:"bytes,19,opt,name=triggered_by,json=triggeredBy,proto3" json:"triggered_by,omitempty"`
+	TriggerEdge     string                 `protobuf:"bytes,20,opt,name=trigger_edge,json=triggerEdge,proto3" json:"trigger_edge,omitempty"`
+	WorkflowRun     string                 `protobuf:"bytes,21,opt,name=workflow_run,json=workflowRun,proto3" json:"workflow_run,omitempty"`
+	ParentOutputs   map[string]string      `protobuf:"bytes,22,rep,name=parent_outputs,json=parentOutputs,proto3" json:"parent_outputs,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *Execution) Reset() {
+	*x = Execution{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_spiderjob_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Execution) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Execution) ProtoMessage() {}
+
+func (x *Execution) ProtoReflect() protoreflect.Message {
+	mi := &file_spiderjob_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Execution.ProtoReflect.Descriptor instead.
+func (*Execution) Descriptor() ([]byte, []int) {
+	return file_spiderjob_proto_rawDescGZIP(), []int{12}
 }
 
-func (m *AgentRunRequest) Reset()         { *m = AgentRunRequest{} }
-func (m *AgentRunRequest) String() string { return proto.CompactTextString(m) }
-func (*AgentRunRequest) ProtoMessage()    {}
-func (*AgentRunRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f956fb9152fd788f, []int{21}
-}
-func (m *AgentRunRequest) XXX_Unmarshal(b []byte) error {
-	return m.Unmarshal(b)
-}
-func (m *AgentRunRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	if deterministic {
-		return xxx_messageInfo_AgentRunRequest.Marshal(b, m, deterministic)
-	} else {
-		b = b[:cap(b)]
-		n, err := m.MarshalToSizedBuffer(b)
-		if err != nil {
-			return nil, err
-		}
-		return b[:n], nil
+func (x *Execution) GetJobName() string {
+	if x != nil {
+		return x.JobName
 	}
-}
-func (m *AgentRunRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_AgentRunRequest.Merge(m, src)
-}
-func (m *AgentRunRequest) XXX_Size() int {
-	return m.Size()
-}
-func (m *AgentRunRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_AgentRunRequest.DiscardUnknown(m)
+	return ""
 }
 
-var xxx_messageInfo_AgentRunRequest proto.InternalMessageInfo
-
-func (m *AgentRunRequest) GetJob() *Job {
-	if m != nil {
-		return m.Job
+func (x *Execution) GetSuccess() bool {
+	if x != nil {
+		return x.Success
 	}
-	return nil
+	return false
 }
 
-func (m *AgentRunRequest) GetExecution() *Execution {
-	if m != nil {
-		return m.Execution
+func (x *Execution) GetOutput() []byte {
+	if x != nil {
+		return x.Output
 	}
 	return nil
 }
 
-func init() {
-	proto.RegisterType((*Job)(nil), "types.Job")
-	proto.RegisterMapType((map[string]string)(nil), "types.Job.ExecutorConfigEntry")
-	proto.RegisterMapType((map[string]string)(nil), "types.Job.MetadataEntry")
-	proto.RegisterMapType((map[string]*PluginConfig)(nil), "types.Job.ProcessorsEntry")
-	proto.RegisterMapType((map[string]string)(nil), "types.Job.TagsEntry")
-	proto.RegisterType((*Job_NullableTime)(nil), "types.Job.NullableTime")
-	proto.RegisterType((*PluginConfig)(nil), "types.PluginConfig")
-	proto.RegisterMapType((map[string]string)(nil), "types.PluginConfig.ConfigEntry")
-	proto.RegisterType((*SetJobRequest)(nil), "types.SetJobRequest")
-	proto.RegisterType((*SetJobResponse)(nil), "types.SetJobResponse")
-	proto.RegisterType((*DeleteJobRequest)(nil), "types.DeleteJobRequest")
-	proto.RegisterType((*DeleteJobResponse)(nil), "types.DeleteJobResponse")
-	proto.RegisterType((*GetJobRequest)(nil), "types.GetJobRequest")
-	proto.RegisterType((*GetJobResponse)(nil), "types.GetJobResponse")
-	proto.RegisterType((*Execution)(nil), "types.Execution")
-	proto.RegisterType((*ExecutionDoneRequest)(nil), "types.ExecutionDoneRequest")
-	proto.RegisterType((*ExecutionDoneResponse)(nil), "types.ExecutionDoneResponse")
-	proto.RegisterType((*RunJobRequest)(nil), "types.RunJobRequest")
-	proto.RegisterType((*RunJobResponse)(nil), "types.RunJobResponse")
-	proto.RegisterType((*ToggleJobRequest)(nil), "types.ToggleJobRequest")
-	proto.RegisterType((*ToggleJobResponse)(nil), "types.ToggleJobResponse")
-	proto.RegisterType((*RaftServer)(nil), "types.RaftServer")
-	proto.RegisterType((*RaftGetConfigurationResponse)(nil), "types.RaftGetConfigurationResponse")
-	proto.RegisterType((*RaftRemovePeerByIDRequest)(nil), "types.RaftRemovePeerByIDRequest")
-	proto.RegisterType((*AgentRunStream)(nil), "types.AgentRunStream")
-	proto.RegisterType((*AgentRunResponse)(nil), "types.AgentRunResponse")
-	proto.RegisterType((*GetActiveExecutionsResponse)(nil), "types.GetActiveExecutionsResponse")
-	proto.RegisterType((*AgentRunRequest)(nil), "types.AgentRunRequest")
-}
-
-func init() { proto.RegisterFile("spiderjob.proto", fileDescriptor_f956fb9152fd788f) }
-
-var fileDescriptor_f956fb9152fd788f = []byte{
-	// 1374 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x56, 0xdd, 0x72, 0xdb, 0xb6,
-	0x12, 0x3e, 0x94, 0x2c, 0x5b, 0x5c, 0xfd, 0xd8, 0x81, 0x9d, 0x84, 0xa1, 0x73, 0x1c, 0x1d, 0x65,
-	0xce, 0x8c, 0xce, 0xc9, 0x84, 0x4e, 0xdd, 0xa6, 0xf9, 0x9b, 0xc9, 0xc4, 0x89, 0x5d, 0x4f, 0x3d,
-	0x6d, 0xea, 0x52, 0x9e, 0x5e, 0xb4, 0x17, 0x1a, 0x48, 0x5c, 0xcb, 0x74, 0x29, 0x82, 0x25, 0x40,
-	0x37, 0xea, 0x65, 0x9f, 0xa2, 0x37, 0x7d, 0x8c, 0xbe, 0x43, 0x2f, 0xfb, 0x08, 0x9d, 0xf4, 0xa2,
-	0xaf, 0xd1, 0x01, 0x40, 0xd2, 0x94, 0x2c, 0xf9, 0x27, 0x77, 0xd8, 0xc5, 0x87, 0xc5, 0xfe, 0x7c,
-	0xbb, 0x00, 0x2c, 0xf3, 0xc8, 0xf7, 0x30, 0x3e, 0x61, 0x7d, 0x27, 0x8a, 0x99, 0x60, 0xa4, 0x22,
-	0xc6, 0x11, 0x72, 0xfb, 0xde, 0x90, 0xb1, 0x61, 0x80, 0x9b, 0x4a, 0xd9, 0x4f, 0x8e, 0x36, 0x85,
-	0x3f, 0x42, 0x2e, 0xe8, 0x28, 0xd2, 0x38, 0x7b, 0x7d, 0x1a, 0x80, 0xa3, 0x48, 0x8c, 0xf5, 0x66,
-	0xfb, 0x37, 0x13, 0xca, 0xfb, 0xac, 0x4f, 0x08, 0x2c, 0x84, 0x74, 0x84, 0x96, 0xd1, 0x32, 0x3a,
-	0xa6, 0xab, 0xd6, 0xc4, 0x86, 0xaa, 0xb4, 0xf5, 0x13, 0x0b, 0xd1, 0x2a, 0x29, 0x7d, 0x2e, 0xcb,
-	0x3d, 0x3e, 0x38, 0x46, 0x2f, 0x09, 0xd0, 0x2a, 0xeb, 0xbd, 0x4c, 0x26, 0x6b, 0x50, 0x61, 0x3f,
-	0x86, 0x18, 0x5b, 0x4b, 0x6a, 0x43, 0x0b, 0xe4, 0x1e, 0xd4, 0xd4, 0xa2, 0x87, 0x23, 0xea, 0x07,
-	0x56, 0x55, 0xed, 0x81, 0x52, 0xed, 0x4a, 0x0d, 0xb9, 0x0f, 0x0d, 0x9e, 0x0c, 0x06, 0xc8, 0x79,
-	0x6f, 0xc0, 0x92, 0x50, 0x58, 0x66, 0xcb, 0xe8, 0x54, 0xdc, 0x7a, 0xaa, 0x7c, 0x23, 0x75, 0xd2,
-	0x0a, 0xc6, 0x31, 0x8b, 0x53, 0x08, 0x28, 0x08, 0x28, 0x95, 0x06, 0xd8, 0x50, 0xf5, 0x7c, 0x4e,
-	0xfb, 0x01, 0x7a, 0x56, 0xad, 0x65, 0x74, 0xaa, 0x6e, 0x2e, 0x93, 0x0e, 0x2c, 0x08, 0x3a, 0xe4,
-	0x56, 0xbd, 0x55, 0xee, 0xd4, 0xb6, 0xd6, 0x1c, 0x95, 0x40, 0x67, 0x9f, 0xf5, 0x9d, 0x43, 0x3a,
-	0xe4, 0xbb, 0xa1, 0x88, 0xc7, 0xae, 0x42, 0x10, 0x0b, 0x96, 0x62, 0x14, 0xb1, 0x8f, 0xdc, 0x6a,
-	0xb4, 0x8c, 0x4e, 0xc3, 0xcd, 0x44, 0xf2, 0x5f, 0x68, 0x7a, 0x18, 0x61, 0xe8, 0x61, 0x28, 0x7a,
-	0x27, 0xac, 0xcf, 0xad, 0x66, 0xab, 0xdc, 0x31, 0xdd, 0x46, 0xae, 0xdd, 0x67, 0x7d, 0x4e, 0xfe,
-	0x0d, 0x10, 0xd1, 0x38, 0xc5, 0x58, 0xcb, 0x2a, 0x58, 0x53, 0x6b, 0x64, 0xba, 0x5b, 0x50, 0x1b,
-	0xb0, 0x70, 0x90, 0xc4, 0x31, 0x86, 0x83, 0xb1, 0xb5, 0xa2, 0xf6, 0x8b, 0x2a, 0x19, 0x07, 0xbe,
-	0xc3, 0x41, 0x22, 0x58, 0x6c, 0xdd, 0xd0, 0x09, 0xce, 0x64, 0xb2, 0x07, 0xcb, 0xd9, 0xba, 0x37,
-	0x60, 0xe1, 0x91, 0x3f, 0xb4, 0x88, 0x0a, 0x69, 0xa3, 0x10, 0xd2, 0x6e, 0x8a, 0x78, 0xa3, 0x00,
-	0x3a, 0xb8, 0x26, 0x4e, 0x28, 0xc9, 0x2d, 0x58, 0xe4, 0x82, 0x8a, 0x84, 0x5b, 0xab, 0xea, 0x8a,
-	0x54, 0x22, 0x9f, 0x40, 0x75, 0x84, 0x82, 0x7a, 0x54, 0x50, 0x6b, 0x4d, 0x59, 0xb6, 0x0a, 0x96,
-	0xbf, 0x4c, 0xb7, 0xb4, 0xcd, 0x1c, 0x49, 0x9e, 0x43, 0x3d, 0xa0, 0x5c, 0xf4, 0xd2, 0x82, 0x59,
-	0x77, 0x5a, 0x46, 0xa7, 0xb6, 0x75, 0xbb, 0x70, 0xf2, 0x6d, 0x12, 0x04, 0xb2, 0x14, 0x87, 0xfe,
-	0x08, 0xdd, 0x9a, 0x04, 0x77, 0x35, 0x96, 0x7c, 0x0a, 0xa0, 0xce, 0xaa, 0x4a, 0x5a, 0xf6, 0xc5,
-	0x27, 0x4d, 0x09, 0xdd, 0x95, 0x48, 0xe2, 0xc0, 0x42, 0x88, 0xef, 0x84, 0x75, 0x5b, 0x9d, 0xb0,
-	0x1d, 0xcd, 0x75, 0x27, 0xe3, 0xba, 0x73, 0x98, 0x35, 0x83, 0xab, 0x70, 0x32, 0xf1, 0x9e, 0xcf,
-	0xa3, 0x80, 0x8e, 0x15, 0xdd, 0x2d, 0x9d, 0xf8, 0x82, 0x8a, 0x3c, 0x07, 0x88, 0x62, 0x26, 0x9d,
-	0x62, 0x31, 0xb7, 0xd6, 0x55, 0xf4, 0x76, 0xc1, 0x93, 0x83, 0x7c, 0x53, 0xc7, 0x5f, 0x40, 0xdb,
-	0x4f, 0xc0, 0xcc, 0x99, 0x44, 0x56, 0xa0, 0xfc, 0x3d, 0x8e, 0xd3, 0x8e, 0x92, 0x4b, 0xd9, 0x18,
-	0xa7, 0x34, 0x48, 0xb2, 0x6e, 0xd2, 0xc2, 0xf3, 0xd2, 0x53, 0xc3, 0xde, 0x86, 0xd5, 0x19, 0xf5,
-	0xba, 0x96, 0x89, 0x17, 0xd0, 0x98, 0x28, 0xcc, 0xb5, 0x0e, 0x7f, 0x07, 0xf5, 0x62, 0x86, 0xc9,
-	0x3a, 0x98, 0xc7, 0x94, 0xf7, 0x34, 0xda, 0xd0, 0x6d, 0x74, 0x4c, 0xf9, 0x37, 0x52, 0x96, 0x39,
-	0x97, 0x73, 0x40, 0x59, 0xb9, 0x24, 0xe7, 0x12, 0x67, 0xbb, 0xb0, 0x3c, 0x95, 0xb4, 0x19, 0xbe,
-	0xfd, 0xaf, 0xe8, 0x5b, 0x6d, 0x6b, 0x35, 0xcd, 0xf8, 0x41, 0x90, 0x0c, 0xfd, 0x50, 0xe7, 0xa4,
-	0xe0, 0x70, 0xfb, 0x67, 0x03, 0xea, 0xc5, 0x3d, 0xf2, 0x04, 0x16, 0xd3, 0x56, 0x30, 0x54, 0xc9,
-	0xee, 0xcd, 0x30, 0xe0, 0x14, 0x7b, 0x21, 0x85, 0xdb, 0xcf, 0xa0, 0xf6, 0x81, 0x29, 0x6f, 0x3f,
-	0x84, 0x46, 0x17, 0x65, 0x3f, 0xbb, 0xf8, 0x43, 0x82, 0x5c, 0x90, 0xbb, 0x50, 0x96, 0xed, 0x6e,
-	0xa8, 0x10, 0xe0, 0x8c, 0x34, 0xae, 0x54, 0xb7, 0x1d, 0x68, 0x66, 0x70, 0x1e, 0xb1, 0x90, 0xe3,
-	0x25, 0xf8, 0x87, 0xb0, 0xb2, 0x83, 0x01, 0x0a, 0x2c, 0xdc, 0x70, 0x07, 0xaa, 0x27, 0xac, 0xdf,
-	0x2b, 0xcc, 0xea, 0xa5, 0x13, 0xd6, 0x7f, 0x4b, 0x47, 0xd8, 0xfe, 0x08, 0x6e, 0x14, 0xe0, 0x57,
-	0xba, 0xe1, 0xff, 0xd0, 0xd8, 0x9b, 0x08, 0xe0, 0x02, 0xf3, 0x0e, 0x34, 0xf7, 0xae, 0xe3, 0xfd,
-	0xaf, 0x25, 0x30, 0x35, 0xa7, 0x7d, 0x16, 0x5e, 0x60, 0x58, 0xce, 0xda, 0x6c, 0x62, 0x94, 0x14,
-	0xd3, 0x32, 0x51, 0x8e, 0x27, 0x96, 0x88, 0x28, 0x11, 0xea, 0x89, 0xa9, 0xbb, 0xa9, 0x24, 0xd9,
-	0x19, 0x32, 0x0f, 0xb5, 0xb5, 0x05, 0x3d, 0x1c, 0xa5, 0x42, 0x99, 0x5b, 0x83, 0xca, 0x30, 0x66,
-	0x49, 0x64, 0x55, 0x5a, 0x46, 0xa7, 0xec, 0x6a, 0x41, 0x5e, 0x42, 0x85, 0x90, 0x2f, 0x9f, 0xb5,
-	0xa8, 0x07, 0x7a, 0x2a, 0x92, 0x67, 0x00, 0x5c, 0xd0, 0x58, 0xa0, 0xd7, 0xa3, 0x42, 0x3d, 0x59,
-	0x17, 0x73, 0xda, 0x4c, 0xd1, 0xdb, 0x82, 0xbc, 0x80, 0xda, 0x91, 0x1f, 0xfa, 0xfc, 0x58, 0x9f,
-	0xad, 0x5e, 0x7a, 0x16, 0x32, 0xf8, 0xb6, 0x68, 0x7f, 0x06, 0x6b, 0x79, 0x7a, 0x76, 0x58, 0x88,
-	0x59, 0x09, 0x1c, 0x30, 0x31, 0xd3, 0xa7, 0xb9, 0x5d, 0x49, 0x73, 0x9b, 0xe3, 0xdd, 0x33, 0x48,
-	0x7b, 0x17, 0x6e, 0x4e, 0xd9, 0x49, 0xcb, 0x43, 0x60, 0xe1, 0x28, 0x66, 0xa3, 0xec, 0x49, 0x97,
-	0x6b, 0x99, 0x86, 0x88, 0x8e, 0x03, 0x46, 0x3d, 0x95, 0xeb, 0xba, 0x9b, 0x89, 0x92, 0x0a, 0x6e,
-	0x12, 0x5e, 0x99, 0x0a, 0x19, 0xf6, 0xaa, 0x44, 0x3e, 0x64, 0xc3, 0x61, 0x70, 0x75, 0x22, 0x17,
-	0xe0, 0x57, 0x23, 0x9b, 0x01, 0xe0, 0xd2, 0x23, 0xd1, 0xc5, 0xf8, 0x14, 0x63, 0xd2, 0x84, 0x92,
-	0xef, 0xa5, 0x66, 0x4b, 0xbe, 0xa7, 0x7e, 0x37, 0xcc, 0xcb, 0x3a, 0x58, 0xad, 0x15, 0x23, 0x3c,
-	0x2f, 0x96, 0xb4, 0xd3, 0x1f, 0x98, 0x4c, 0x94, 0xb4, 0x0b, 0x90, 0x7a, 0x18, 0x2b, 0x6e, 0x55,
-	0xdd, 0x54, 0x52, 0x83, 0x80, 0x09, 0x8c, 0x15, 0xb3, 0xaa, 0xae, 0x16, 0xe4, 0xb7, 0x25, 0xa6,
-	0x47, 0xa2, 0xa7, 0xca, 0x3d, 0x60, 0x81, 0xe2, 0x97, 0xe9, 0xd6, 0xa5, 0xf2, 0x20, 0xd5, 0xb5,
-	0x29, 0xdc, 0x95, 0xee, 0xed, 0xa1, 0xd0, 0xb3, 0x26, 0x89, 0xa9, 0xaa, 0x63, 0x16, 0xdd, 0x03,
-	0x58, 0xe2, 0xca, 0x75, 0x9e, 0x8e, 0xaf, 0x1b, 0x69, 0x84, 0x67, 0x41, 0xb9, 0x19, 0x42, 0xfa,
-	0xe1, 0x87, 0x1e, 0xbe, 0x53, 0xe1, 0x2c, 0xb8, 0x5a, 0x68, 0x3f, 0x80, 0x3b, 0x12, 0xec, 0xe2,
-	0x88, 0x9d, 0xe2, 0x01, 0x62, 0xfc, 0x7a, 0xfc, 0xf9, 0x4e, 0x96, 0xed, 0xa9, 0x84, 0xb4, 0x5f,
-	0x41, 0x73, 0x7b, 0x88, 0xa1, 0x70, 0x93, 0xb0, 0x2b, 0x62, 0xa4, 0xa3, 0x6b, 0xd3, 0xee, 0x15,
-	0xac, 0x64, 0x16, 0x3e, 0x90, 0x71, 0x5f, 0xc1, 0xfa, 0x1e, 0x8a, 0xed, 0x81, 0xf0, 0x4f, 0x31,
-	0xbf, 0x82, 0xe7, 0xc6, 0x1e, 0x01, 0xe4, 0xb7, 0x65, 0x59, 0x39, 0xef, 0x51, 0x01, 0xd3, 0xee,
-	0xc1, 0xf2, 0x99, 0x4b, 0x57, 0x18, 0xc8, 0x93, 0x31, 0x97, 0x2e, 0x8d, 0x79, 0xeb, 0xef, 0x0a,
-	0x98, 0xdd, 0xec, 0x17, 0x4e, 0x1e, 0xc3, 0xa2, 0x1e, 0x88, 0x24, 0xfb, 0x49, 0x4e, 0xcc, 0x52,
-	0xfb, 0xe6, 0x94, 0x36, 0x8d, 0x6b, 0x1f, 0x1a, 0x13, 0xfd, 0x4a, 0xd6, 0xa7, 0xaf, 0x2c, 0x4c,
-	0x03, 0xfb, 0xee, 0xec, 0xcd, 0xd4, 0xd6, 0x13, 0xa8, 0x7c, 0x81, 0xf4, 0x14, 0xc9, 0xad, 0x73,
-	0x43, 0x67, 0x57, 0x7e, 0xf2, 0xed, 0x39, 0x7a, 0xe9, 0x7b, 0x77, 0xd2, 0xf7, 0xee, 0x4c, 0xdf,
-	0xa7, 0xde, 0xab, 0x97, 0x60, 0xe6, 0x4f, 0x0c, 0xc9, 0xbe, 0x67, 0xd3, 0x6f, 0x94, 0x6d, 0x9d,
-	0xdf, 0x48, 0xcf, 0x3f, 0x86, 0x45, 0x3d, 0x38, 0xf2, 0x6b, 0x27, 0x66, 0x4e, 0x7e, 0xed, 0xd4,
-	0x74, 0x79, 0x09, 0x66, 0x3e, 0x10, 0xf2, 0x6b, 0xa7, 0x27, 0x4a, 0x7e, 0xed, 0xf9, 0xd9, 0xd1,
-	0x85, 0xb5, 0x59, 0xdd, 0x37, 0x37, 0x6b, 0xf7, 0x0b, 0xcd, 0x37, 0xb7, 0x65, 0xdf, 0x02, 0x39,
-	0xdf, 0x6f, 0xa4, 0x55, 0x38, 0x3a, 0xb3, 0x15, 0xe7, 0x96, 0xe4, 0x6b, 0x58, 0x9d, 0xd1, 0x0e,
-	0x73, 0x7d, 0x6c, 0x9f, 0xb1, 0x6b, 0x6e, 0x0b, 0x3d, 0x85, 0x7a, 0x17, 0xc5, 0xd9, 0x23, 0x7c,
-	0x8e, 0xdc, 0xf3, 0x9c, 0xd9, 0xda, 0x81, 0x8a, 0x6a, 0x25, 0xf2, 0x02, 0xaa, 0x59, 0x4f, 0x91,
-	0x5b, 0xe9, 0xf1, 0xa9, 0x26, 0xcb, 0xab, 0x36, 0x39, 0x51, 0x1e, 0x19, 0xaf, 0xff, 0xf3, 0xfb,
-	0xfb, 0x0d, 0xe3, 0x8f, 0xf7, 0x1b, 0xc6, 0x9f, 0xef, 0x37, 0x8c, 0x5f, 0xfe, 0xda, 0xf8, 0xd7,
-	0xb7, 0xcb, 0x8e, 0xb3, 0x19, 0xa9, 0x1f, 0xd9, 0xa6, 0x3a, 0xd3, 0x5f, 0x54, 0x17, 0x7f, 0xfc,
-	0x4f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x6e, 0xa4, 0x84, 0xdd, 0xde, 0x0e, 0x00, 0x00,
-}
-
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
-
-// SpiderjobClient is the client API for Spiderjob service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type SpiderjobClient interface {
-	GetJob(ctx context.Context, in *GetJobRequest, opts ...grpc.CallOption) (*GetJobResponse, error)
-	ExecutionDone(ctx context.Context, in *ExecutionDoneRequest, opts ...grpc.CallOption) (*ExecutionDoneResponse, error)
-	Leave(ctx context.Context, in *protobuf.Empty, opts ...grpc.CallOption) (*protobuf.Empty, error)
-	SetJob(ctx context.Context, in *SetJobRequest, opts ...grpc.CallOption) (*SetJobResponse, error)
-	DeleteJob(ctx context.Context, in *DeleteJobRequest, opts ...grpc.CallOption) (*DeleteJobResponse, error)
-	RunJob(ctx context.Context, in *RunJobRequest, opts ...grpc.CallOption) (*RunJobResponse, error)
-	ToggleJob(ctx context.Context, in *ToggleJobRequest, opts ...grpc.CallOption) (*ToggleJobResponse, error)
-	RaftGetConfiguration(ctx context.Context, in *protobuf.Empty, opts ...grpc.CallOption) (*RaftGetConfigurationResponse, error)
-	RaftRemovePeerByID(ctx context.Context, in *RaftRemovePeerByIDRequest, opts ...grpc.CallOption) (*protobuf.Empty, error)
-	GetActiveExecutions(ctx context.Context, in *protobuf.Empty, opts ...grpc.CallOption) (*GetActiveExecutionsResponse, error)
-	SetExecution(ctx context.Context, in *Execution, opts ...grpc.CallOption) (*protobuf.Empty, error)
-}
-
-type spiderjobClient struct {
-	cc *grpc.ClientConn
-}
-
-func NewSpiderjobClient(cc *grpc.ClientConn) SpiderjobClient {
-	return &spiderjobClient{cc}
-}
-
-func (c *spiderjobClient) GetJob(ctx context.Context, in *GetJobRequest, opts ...grpc.CallOption) (*GetJobResponse, error) {
-	out := new(GetJobResponse)
-	err := c.cc.Invoke(ctx, "/types.Spiderjob/GetJob", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
-}
-
-func (c *spiderjobClient) ExecutionDone(ctx context.Context, in *ExecutionDoneRequest, opts ...grpc.CallOption) (*ExecutionDoneResponse, error) {
-	out := new(ExecutionDoneResponse)
-	err := c.cc.Invoke(ctx, "/types.Spiderjob/ExecutionDone", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
-}
-
-func (c *spiderjobClient) Leave(ctx context.Context, in *protobuf.Empty, opts ...grpc.CallOption) (*protobuf.Empty, error) {
-	out := new(protobuf.Empty)
-	err := c.cc.Invoke(ctx, "/types.Spiderjob/Leave", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
-}
-
-func (c *spiderjobClient) SetJob(ctx context.Context, in *SetJobRequest, opts ...grpc.CallOption) (*SetJobResponse, error) {
-	out := new(SetJobResponse)
-	err := c.cc.Invoke(ctx, "/types.Spiderjob/SetJob", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (x *Execution) GetNodeName() string {
+	if x != nil {
+		return x.NodeName
 	}
-	return out, nil
+	return ""
 }
 
-func (c *spiderjobClient) DeleteJob(ctx context.Context, in *DeleteJobRequest, opts ...grpc.CallOption) (*DeleteJobResponse, error) {
-	out := new(DeleteJobResponse)
-	err := c.cc.Invoke(ctx, "/types.Spiderjob/DeleteJob", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (x *Execution) GetGroup() int64 {
+	if x != nil {
+		return x.Group
 	}
-	return out, nil
+	return 0
 }
 
-func (c *spiderjobClient) RunJob(ctx context.Context, in *RunJobRequest, opts ...grpc.CallOption) (*RunJobResponse, error) {
-	out := new(RunJobResponse)
-	err := c.cc.Invoke(ctx, "/types.Spiderjob/RunJob", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (x *Execution) GetAttempt() uint32 {
+	if x != nil {
+		return x.Attempt
 	}
-	return out, nil
+	return 0
 }
 
-func (c *spiderjobClient) ToggleJob(ctx context.Context, in *ToggleJobRequest, opts ...grpc.CallOption) (*ToggleJobResponse, error) {
-	out := new(ToggleJobResponse)
-	err := c.cc.Invoke(ctx, "/types.Spiderjob/ToggleJob", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (x *Execution) GetStartedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartedAt
 	}
-	return out, nil
+	return nil
 }
 
-func (c *spiderjobClient) RaftGetConfiguration(ctx context.Context, in *protobuf.Empty, opts ...grpc.CallOption) (*RaftGetConfigurationResponse, error) {
-	out := new(RaftGetConfigurationResponse)
-	err := c.cc.Invoke(ctx, "/types.Spiderjob/RaftGetConfiguration", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (x *Execution) GetFinishedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.FinishedAt
 	}
-	return out, nil
+	return nil
 }
 
-func (c *spiderjobClient) RaftRemovePeerByID(ctx context.Context, in *RaftRemovePeerByIDRequest, opts ...grpc.CallOption) (*protobuf.Empty, error) {
-	out := new(protobuf.Empty)
-	err := c.cc.Invoke(ctx, "/types.Spiderjob/RaftRemovePeerByID", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (x *Execution) GetTimedOut() bool {
+	if x != nil {
+		return x.TimedOut
 	}
-	return out, nil
+	return false
 }
 
-func (c *spiderjobClient) GetActiveExecutions(ctx context.Context, in *protobuf.Empty, opts ...grpc.CallOption) (*GetActiveExecutionsResponse, error) {
-	out := new(GetActiveExecutionsResponse)
-	err := c.cc.Invoke(ctx, "/types.Spiderjob/GetActiveExecutions", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (x *Execution) GetCancelled() bool {
+	if x != nil {
+		return x.Cancelled
 	}
-	return out, nil
+	return false
 }
 
-func (c *spiderjobClient) SetExecution(ctx context.Context, in *Execution, opts ...grpc.CallOption) (*protobuf.Empty, error) {
-	out := new(protobuf.Empty)
-	err := c.cc.Invoke(ctx, "/types.Spiderjob/SetExecution", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (x *Execution) GetCancelledBy() string {
+	if x != nil {
+		return x.CancelledBy
 	}
-	return out, nil
+	return ""
 }
 
-// SpiderjobServer is the server API for Spiderjob service.
-type SpiderjobServer interface {
-	GetJob(context.Context, *GetJobRequest) (*GetJobResponse, error)
-	ExecutionDone(context.Context, *ExecutionDoneRequest) (*ExecutionDoneResponse, error)
-	Leave(context.Context, *protobuf.Empty) (*protobuf.Empty, error)
-	SetJob(context.Context, *SetJobRequest) (*SetJobResponse, error)
-	DeleteJob(context.Context, *DeleteJobRequest) (*DeleteJobResponse, error)
-	RunJob(context.Context, *RunJobRequest) (*RunJobResponse, error)
-	ToggleJob(context.Context, *ToggleJobRequest) (*ToggleJobResponse, error)
-	RaftGetConfiguration(context.Context, *protobuf.Empty) (*RaftGetConfigurationResponse, error)
-	RaftRemovePeerByID(context.Context, *RaftRemovePeerByIDRequest) (*protobuf.Empty, error)
-	GetActiveExecutions(context.Context, *protobuf.Empty) (*GetActiveExecutionsResponse, error)
-	SetExecution(context.Context, *Execution) (*protobuf.Empty, error)
+func (x *Execution) GetExitCode() int32 {
+	if x != nil {
+		return x.ExitCode
+	}
+	return 0
 }
 
-// UnimplementedSpiderjobServer can be embedded to have forward compatible implementations.
-type UnimplementedSpiderjobServer struct {
+func (x *Execution) GetStdout() []byte {
+	if x != nil {
+		return x.Stdout
+	}
+	return nil
 }
 
-func (*UnimplementedSpiderjobServer) GetJob(ctx context.Context, req *GetJobRequest) (*GetJobResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetJob not implemented")
-}
-func (*UnimplementedSpiderjobServer) ExecutionDone(ctx context.Context, req *ExecutionDoneRequest) (*ExecutionDoneResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ExecutionDone not implemented")
-}
-func (*UnimplementedSpiderjobServer) Leave(ctx context.Context, req *protobuf.Empty) (*protobuf.Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Leave not implemented")
-}
-func (*UnimplementedSpiderjobServer) SetJob(ctx context.Context, req *SetJobRequest) (*SetJobResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SetJob not implemented")
-}
-func (*UnimplementedSpiderjobServer) DeleteJob(ctx context.Context, req *DeleteJobRequest) (*DeleteJobResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteJob not implemented")
-}
-func (*UnimplementedSpiderjobServer) RunJob(ctx context.Context, req *RunJobRequest) (*RunJobResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RunJob not implemented")
-}
-func (*UnimplementedSpiderjobServer) ToggleJob(ctx context.Context, req *ToggleJobRequest) (*ToggleJobResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ToggleJob not implemented")
-}
-func (*UnimplementedSpiderjobServer) RaftGetConfiguration(ctx context.Context, req *protobuf.Empty) (*RaftGetConfigurationResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RaftGetConfiguration not implemented")
-}
-func (*UnimplementedSpiderjobServer) RaftRemovePeerByID(ctx context.Context, req *RaftRemovePeerByIDRequest) (*protobuf.Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RaftRemovePeerByID not implemented")
-}
-func (*UnimplementedSpiderjobServer) GetActiveExecutions(ctx context.Context, req *protobuf.Empty) (*GetActiveExecutionsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetActiveExecutions not implemented")
-}
-func (*UnimplementedSpiderjobServer) SetExecution(ctx context.Context, req *Execution) (*protobuf.Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SetExecution not implemented")
+func (x *Execution) GetStderr() []byte {
+	if x != nil {
+		return x.Stderr
+	}
+	return nil
 }
 
-func RegisterSpiderjobServer(s *grpc.Server, srv SpiderjobServer) {
-	s.RegisterService(&_Spiderjob_serviceDesc, srv)
+func (x *Execution) GetFailureCategory() string {
+	if x != nil {
+		return x.FailureCategory
+	}
+	return ""
 }
 
-func _Spiderjob_GetJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetJobRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(SpiderjobServer).GetJob(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/types.Spiderjob/GetJob",
+func (x *Execution) GetResults() map[string]string {
+	if x != nil {
+		return x.Results
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SpiderjobServer).GetJob(ctx, req.(*GetJobRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Spiderjob_ExecutionDone_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ExecutionDoneRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(SpiderjobServer).ExecutionDone(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/types.Spiderjob/ExecutionDone",
+func (x *Execution) GetSkipped() bool {
+	if x != nil {
+		return x.Skipped
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SpiderjobServer).ExecutionDone(ctx, req.(*ExecutionDoneRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return false
 }
 
-func _Spiderjob_Leave_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(protobuf.Empty)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(SpiderjobServer).Leave(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/types.Spiderjob/Leave",
+func (x *Execution) GetSkipReason() string {
+	if x != nil {
+		return x.SkipReason
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SpiderjobServer).Leave(ctx, req.(*protobuf.Empty))
-	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Spiderjob_SetJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SetJobRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(SpiderjobServer).SetJob(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/types.Spiderjob/SetJob",
+func (x *Execution) GetTriggeredBy() string {
+	if x != nil {
+		return x.TriggeredBy
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SpiderjobServer).SetJob(ctx, req.(*SetJobRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Spiderjob_DeleteJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteJobRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(SpiderjobServer).DeleteJob(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/types.Spiderjob/DeleteJob",
+func (x *Execution) GetTriggerEdge() string {
+	if x != nil {
+		return x.TriggerEdge
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SpiderjobServer).DeleteJob(ctx, req.(*DeleteJobRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Spiderjob_RunJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RunJobRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(SpiderjobServer).RunJob(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/types.Spiderjob/RunJob",
+func (x *Execution) GetWorkflowRun() string {
+	if x != nil {
+		return x.WorkflowRun
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SpiderjobServer).RunJob(ctx, req.(*RunJobRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Spiderjob_ToggleJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ToggleJobRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(SpiderjobServer).ToggleJob(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/types.Spiderjob/ToggleJob",
+func (x *Execution) GetParentOutputs() map[string]string {
+	if x != nil {
+		return x.ParentOutputs
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SpiderjobServer).ToggleJob(ctx, req.(*ToggleJobRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Spiderjob_RaftGetConfiguration_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(protobuf.Empty)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(SpiderjobServer).RaftGetConfiguration(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/types.Spiderjob/RaftGetConfiguration",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SpiderjobServer).RaftGetConfiguration(ctx, req.(*protobuf.Empty))
-	}
-	return interceptor(ctx, in, info, handler)
+type ExecutionDoneRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Execution *Execution `protobuf:"bytes,1,opt,name=execution,proto3" json:"execution,omitempty"`
 }
 
-func _Spiderjob_RaftRemovePeerByID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RaftRemovePeerByIDRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+func (x *ExecutionDoneRequest) Reset() {
+	*x = ExecutionDoneRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_spiderjob_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	if interceptor == nil {
-		return srv.(SpiderjobServer).RaftRemovePeerByID(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/types.Spiderjob/RaftRemovePeerByID",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SpiderjobServer).RaftRemovePeerByID(ctx, req.(*RaftRemovePeerByIDRequest))
-	}
-	return interceptor(ctx, in, info, handler)
 }
 
-func _Spiderjob_GetActiveExecutions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(protobuf.Empty)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(SpiderjobServer).GetActiveExecutions(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/types.Spiderjob/GetActiveExecutions",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SpiderjobServer).GetActiveExecutions(ctx, req.(*protobuf.Empty))
-	}
-	return interceptor(ctx, in, info, handler)
+func (x *ExecutionDoneRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func _Spiderjob_SetExecution_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(Execution)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(SpiderjobServer).SetExecution(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/types.Spiderjob/SetExecution",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SpiderjobServer).SetExecution(ctx, req.(*Execution))
+func (*ExecutionDoneRequest) ProtoMessage() {}
+
+func (x *ExecutionDoneRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_spiderjob_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return interceptor(ctx, in, info, handler)
+	return mi.MessageOf(x)
 }
 
-var _Spiderjob_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "types.Spiderjob",
-	HandlerType: (*SpiderjobServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "GetJob",
-			Handler:    _Spiderjob_GetJob_Handler,
-		},
-		{
-			MethodName: "ExecutionDone",
-			Handler:    _Spiderjob_ExecutionDone_Handler,
-		},
-		{
-			MethodName: "Leave",
-			Handler:    _Spiderjob_Leave_Handler,
-		},
-		{
-			MethodName: "SetJob",
-			Handler:    _Spiderjob_SetJob_Handler,
-		},
-		{
-			MethodName: "DeleteJob",
-			Handler:    _Spiderjob_DeleteJob_Handler,
-		},
-		{
-			MethodName: "RunJob",
-			Handler:    _Spiderjob_RunJob_Handler,
-		},
-		{
-			MethodName: "ToggleJob",
-			Handler:    _Spiderjob_ToggleJob_Handler,
-		},
-		{
-			MethodName: "RaftGetConfiguration",
-			Handler:    _Spiderjob_RaftGetConfiguration_Handler,
-		},
-		{
-			MethodName: "RaftRemovePeerByID",
-			Handler:    _Spiderjob_RaftRemovePeerByID_Handler,
-		},
-		{
-			MethodName: "GetActiveExecutions",
-			Handler:    _Spiderjob_GetActiveExecutions_Handler,
-		},
-		{
-			MethodName: "SetExecution",
-			Handler:    _Spiderjob_SetExecution_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "spiderjob.proto",
+// Deprecated: Use ExecutionDoneRequest.ProtoReflect.Descriptor instead.
+func (*ExecutionDoneRequest) Descriptor() ([]byte, []int) {
+	return file_spiderjob_proto_rawDescGZIP(), []int{13}
 }
 
-// AgentClient is the client API for Agent service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type AgentClient interface {
-	AgentRun(ctx context.Context, in *AgentRunRequest, opts ...grpc.CallOption) (Agent_AgentRunClient, error)
+func (x *ExecutionDoneRequest) GetExecution() *Execution {
+	if x != nil {
+		return x.Execution
+	}
+	return nil
 }
 
-type agentClient struct {
-	cc *grpc.ClientConn
-}
+type ExecutionDoneResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-func NewAgentClient(cc *grpc.ClientConn) AgentClient {
-	return &agentClient{cc}
+	From    string `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	Payload []byte `protobuf:"bytes,2,opt,name=payload,proto3" json:"payload,omitempty"`
 }
 
-func (c *agentClient) AgentRun(ctx context.Context, in *AgentRunRequest, opts ...grpc.CallOption) (Agent_AgentRunClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_Agent_serviceDesc.Streams[0], "/types.Agent/AgentRun", opts...)
-	if err != nil {
-		return nil, err
+func (x *ExecutionDoneResponse) Reset() {
+	*x = ExecutionDoneResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_spiderjob_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	x := &agentAgentRunClient{stream}
-	if err := x.ClientStream.SendMsg(in); err != nil {
-		return nil, err
-	}
-	if err := x.ClientStream.CloseSend(); err != nil {
-		return nil, err
-	}
-	return x, nil
 }
 
-type Agent_AgentRunClient interface {
-	Recv() (*AgentRunStream, error)
-	grpc.ClientStream
+func (x *ExecutionDoneResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-type agentAgentRunClient struct {
-	grpc.ClientStream
-}
+func (*ExecutionDoneResponse) ProtoMessage() {}
 
-func (x *agentAgentRunClient) Recv() (*AgentRunStream, error) {
-	m := new(AgentRunStream)
-	if err := x.ClientStream.RecvMsg(m); err != nil {
-		return nil, err
+func (x *ExecutionDoneResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_spiderjob_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return m, nil
+	return mi.MessageOf(x)
 }
 
-// AgentServer is the server API for Agent service.
-type AgentServer interface {
-	AgentRun(*AgentRunRequest, Agent_AgentRunServer) error
+// Deprecated: Use ExecutionDoneResponse.ProtoReflect.Descriptor instead.
+func (*ExecutionDoneResponse) Descriptor() ([]byte, []int) {
+	return file_spiderjob_proto_rawDescGZIP(), []int{14}
 }
 
-// UnimplementedAgentServer can be embedded to have forward compatible implementations.
-type UnimplementedAgentServer struct {
+func (x *ExecutionDoneResponse) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
 }
 
-func (*UnimplementedAgentServer) AgentRun(req *AgentRunRequest, srv Agent_AgentRunServer) error {
-	return status.Errorf(codes.Unimplemented, "method AgentRun not implemented")
+func (x *ExecutionDoneResponse) GetPayload() []byte {
+	if x != nil {
+		return x.Payload
+	}
+	return nil
 }
 
-func RegisterAgentServer(s *grpc.Server, srv AgentServer) {
-	s.RegisterService(&_Agent_serviceDesc, srv)
+type RunJobRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	JobName string `protobuf:"bytes,1,opt,name=job_name,json=jobName,proto3" json:"job_name,omitempty"`
+	Force   bool   `protobuf:"varint,2,opt,name=force,proto3" json:"force,omitempty"`
 }
 
-func _Agent_AgentRun_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(AgentRunRequest)
-	if err := stream.RecvMsg(m); err != nil {
-		return err
+func (x *RunJobRequest) Reset() {
+	*x = RunJobRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_spiderjob_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return srv.(AgentServer).AgentRun(m, &agentAgentRunServer{stream})
 }
 
-type Agent_AgentRunServer interface {
-	Send(*AgentRunStream) error
-	grpc.ServerStream
+func (x *RunJobRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-type agentAgentRunServer struct {
-	grpc.ServerStream
+func (*RunJobRequest) ProtoMessage() {}
+
+func (x *RunJobRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_spiderjob_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
 
-func (x *agentAgentRunServer) Send(m *AgentRunStream) error {
-	return x.ServerStream.SendMsg(m)
+// Deprecated: Use RunJobRequest.ProtoReflect.Descriptor instead.
+func (*RunJobRequest) Descriptor() ([]byte, []int) {
+	return file_spiderjob_proto_rawDescGZIP(), []int{15}
 }
 
-var _Agent_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "types.Agent",
-	HandlerType: (*AgentServer)(nil),
-	Methods:     []grpc.MethodDesc{},
-	Streams: []grpc.StreamDesc{
-		{
-			StreamName:    "AgentRun",
-			Handler:       _Agent_AgentRun_Handler,
-			ServerStreams: true,
-		},
-	},
-	Metadata: "spiderjob.proto",
-}
-
-func (m *Job) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
-
-func (m *Job) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *Job) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if len(m.Processors) > 0 {
-		for k := range m.Processors {
-			v := m.Processors[k]
-			baseI := i
-			if v != nil {
-				{
-					size, err := v.MarshalToSizedBuffer(dAtA[:i])
-					if err != nil {
-						return 0, err
-					}
-					i -= size
-					i = encodeVarintSpiderjob(dAtA, i, uint64(size))
-				}
-				i--
-				dAtA[i] = 0x12
-			}
-			i -= len(k)
-			copy(dAtA[i:], k)
-			i = encodeVarintSpiderjob(dAtA, i, uint64(len(k)))
-			i--
-			dAtA[i] = 0xa
-			i = encodeVarintSpiderjob(dAtA, i, uint64(baseI-i))
-			i--
-			dAtA[i] = 0x1
-			i--
-			dAtA[i] = 0xda
-		}
-	}
-	if m.LastError != nil {
-		{
-			size, err := m.LastError.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintSpiderjob(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0xd2
-	}
-	if m.LastSuccess != nil {
-		{
-			size, err := m.LastSuccess.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintSpiderjob(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0xca
-	}
-	if len(m.Displayname) > 0 {
-		i -= len(m.Displayname)
-		copy(dAtA[i:], m.Displayname)
-		i = encodeVarintSpiderjob(dAtA, i, uint64(len(m.Displayname)))
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0xc2
-	}
-	if m.Next != nil {
-		{
-			size, err := m.Next.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintSpiderjob(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0xba
-	}
-	if len(m.Metadata) > 0 {
-		for k := range m.Metadata {
-			v := m.Metadata[k]
-			baseI := i
-			i -= len(v)
-			copy(dAtA[i:], v)
-			i = encodeVarintSpiderjob(dAtA, i, uint64(len(v)))
-			i--
-			dAtA[i] = 0x12
-			i -= len(k)
-			copy(dAtA[i:], k)
-			i = encodeVarintSpiderjob(dAtA, i, uint64(len(k)))
-			i--
-			dAtA[i] = 0xa
-			i = encodeVarintSpiderjob(dAtA, i, uint64(baseI-i))
-			i--
-			dAtA[i] = 0x1
-			i--
-			dAtA[i] = 0xa2
-		}
-	}
-	if len(m.Status) > 0 {
-		i -= len(m.Status)
-		copy(dAtA[i:], m.Status)
-		i = encodeVarintSpiderjob(dAtA, i, uint64(len(m.Status)))
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0x9a
-	}
-	if len(m.ExecutorConfig) > 0 {
-		for k := range m.ExecutorConfig {
-			v := m.ExecutorConfig[k]
-			baseI := i
-			i -= len(v)
-			copy(dAtA[i:], v)
-			i = encodeVarintSpiderjob(dAtA, i, uint64(len(v)))
-			i--
-			dAtA[i] = 0x12
-			i -= len(k)
-			copy(dAtA[i:], k)
-			i = encodeVarintSpiderjob(dAtA, i, uint64(len(k)))
-			i--
-			dAtA[i] = 0xa
-			i = encodeVarintSpiderjob(dAtA, i, uint64(baseI-i))
-			i--
-			dAtA[i] = 0x1
-			i--
-			dAtA[i] = 0x92
-		}
+func (x *RunJobRequest) GetJobName() string {
+	if x != nil {
+		return x.JobName
 	}
-	if len(m.Executor) > 0 {
-		i -= len(m.Executor)
-		copy(dAtA[i:], m.Executor)
-		i = encodeVarintSpiderjob(dAtA, i, uint64(len(m.Executor)))
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0x8a
-	}
-	if len(m.Concurrency) > 0 {
-		i -= len(m.Concurrency)
-		copy(dAtA[i:], m.Concurrency)
-		i = encodeVarintSpiderjob(dAtA, i, uint64(len(m.Concurrency)))
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0x82
-	}
-	if len(m.ParentJob) > 0 {
-		i -= len(m.ParentJob)
-		copy(dAtA[i:], m.ParentJob)
-		i = encodeVarintSpiderjob(dAtA, i, uint64(len(m.ParentJob)))
-		i--
-		dAtA[i] = 0x7a
-	}
-	if len(m.DependentJobs) > 0 {
-		for iNdEx := len(m.DependentJobs) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.DependentJobs[iNdEx])
-			copy(dAtA[i:], m.DependentJobs[iNdEx])
-			i = encodeVarintSpiderjob(dAtA, i, uint64(len(m.DependentJobs[iNdEx])))
-			i--
-			dAtA[i] = 0x72
-		}
-	}
-	if m.Retries != 0 {
-		i = encodeVarintSpiderjob(dAtA, i, uint64(m.Retries))
-		i--
-		dAtA[i] = 0x68
-	}
-	if len(m.Tags) > 0 {
-		for k := range m.Tags {
-			v := m.Tags[k]
-			baseI := i
-			i -= len(v)
-			copy(dAtA[i:], v)
-			i = encodeVarintSpiderjob(dAtA, i, uint64(len(v)))
-			i--
-			dAtA[i] = 0x12
-			i -= len(k)
-			copy(dAtA[i:], k)
-			i = encodeVarintSpiderjob(dAtA, i, uint64(len(k)))
-			i--
-			dAtA[i] = 0xa
-			i = encodeVarintSpiderjob(dAtA, i, uint64(baseI-i))
-			i--
-			dAtA[i] = 0x62
-		}
-	}
-	if m.Disabled {
-		i--
-		if m.Disabled {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x58
-	}
-	if m.ErrorCount != 0 {
-		i = encodeVarintSpiderjob(dAtA, i, uint64(m.ErrorCount))
-		i--
-		dAtA[i] = 0x50
-	}
-	if m.SuccessCount != 0 {
-		i = encodeVarintSpiderjob(dAtA, i, uint64(m.SuccessCount))
-		i--
-		dAtA[i] = 0x48
-	}
-	if len(m.OwnerEmail) > 0 {
-		i -= len(m.OwnerEmail)
-		copy(dAtA[i:], m.OwnerEmail)
-		i = encodeVarintSpiderjob(dAtA, i, uint64(len(m.OwnerEmail)))
-		i--
-		dAtA[i] = 0x42
-	}
-	if len(m.Owner) > 0 {
-		i -= len(m.Owner)
-		copy(dAtA[i:], m.Owner)
-		i = encodeVarintSpiderjob(dAtA, i, uint64(len(m.Owner)))
-		i--
-		dAtA[i] = 0x3a
-	}
-	if len(m.Schedule) > 0 {
-		i -= len(m.Schedule)
-		copy(dAtA[i:], m.Schedule)
-		i = encodeVarintSpiderjob(dAtA, i, uint64(len(m.Schedule)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Timezone) > 0 {
-		i -= len(m.Timezone)
-		copy(dAtA[i:], m.Timezone)
-		i = encodeVarintSpiderjob(dAtA, i, uint64(len(m.Timezone)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Name) > 0 {
-		i -= len(m.Name)
-		copy(dAtA[i:], m.Name)
-		i = encodeVarintSpiderjob(dAtA, i, uint64(len(m.Name)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
-}
-
-func (m *Job_NullableTime) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
-
-func (m *Job_NullableTime) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *Job_NullableTime) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if m.Time != nil {
-		{
-			size, err := m.Time.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintSpiderjob(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.HasValue {
-		i--
-		if m.HasValue {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
-}
-
-func (m *PluginConfig) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
-
-func (m *PluginConfig) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *PluginConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if len(m.Config) > 0 {
-		for k := range m.Config {
-			v := m.Config[k]
-			baseI := i
-			i -= len(v)
-			copy(dAtA[i:], v)
-			i = encodeVarintSpiderjob(dAtA, i, uint64(len(v)))
-			i--
-			dAtA[i] = 0x12
-			i -= len(k)
-			copy(dAtA[i:], k)
-			i = encodeVarintSpiderjob(dAtA, i, uint64(len(k)))
-			i--
-			dAtA[i] = 0xa
-			i = encodeVarintSpiderjob(dAtA, i, uint64(baseI-i))
-			i--
-			dAtA[i] = 0xa
-		}
-	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *SetJobRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (x *RunJobRequest) GetForce() bool {
+	if x != nil {
+		return x.Force
 	}
-	return dAtA[:n], nil
+	return false
 }
 
-func (m *SetJobRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
+type RunJobResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-func (m *SetJobRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if m.Job != nil {
-		{
-			size, err := m.Job.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintSpiderjob(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+	Job *Job `protobuf:"bytes,1,opt,name=job,proto3" json:"job,omitempty"`
 }
 
-func (m *SetJobResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (x *RunJobResponse) Reset() {
+	*x = RunJobResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_spiderjob_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return dAtA[:n], nil
 }
 
-func (m *SetJobResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (x *RunJobResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (m *SetJobResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if m.Job != nil {
-		{
-			size, err := m.Job.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintSpiderjob(dAtA, i, uint64(size))
+func (*RunJobResponse) ProtoMessage() {}
+
+func (x *RunJobResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_spiderjob_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		i--
-		dAtA[i] = 0xa
+		return ms
 	}
-	return len(dAtA) - i, nil
+	return mi.MessageOf(x)
 }
 
-func (m *DeleteJobRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+// Deprecated: Use RunJobResponse.ProtoReflect.Descriptor instead.
+func (*RunJobResponse) Descriptor() ([]byte, []int) {
+	return file_spiderjob_proto_rawDescGZIP(), []int{16}
 }
 
-func (m *DeleteJobRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (x *RunJobResponse) GetJob() *Job {
+	if x != nil {
+		return x.Job
+	}
+	return nil
 }
 
-func (m *DeleteJobRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if len(m.JobName) > 0 {
-		i -= len(m.JobName)
-		copy(dAtA[i:], m.JobName)
-		i = encodeVarintSpiderjob(dAtA, i, uint64(len(m.JobName)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+type ToggleJobRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	JobName string `protobuf:"bytes,1,opt,name=job_name,json=jobName,proto3" json:"job_name,omitempty"`
 }
 
-func (m *DeleteJobResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (x *ToggleJobRequest) Reset() {
+	*x = ToggleJobRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_spiderjob_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return dAtA[:n], nil
 }
 
-func (m *DeleteJobResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (x *ToggleJobRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (m *DeleteJobResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if m.Job != nil {
-		{
-			size, err := m.Job.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintSpiderjob(dAtA, i, uint64(size))
+func (*ToggleJobRequest) ProtoMessage() {}
+
+func (x *ToggleJobRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_spiderjob_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		i--
-		dAtA[i] = 0xa
+		return ms
 	}
-	return len(dAtA) - i, nil
+	return mi.MessageOf(x)
 }
 
-func (m *GetJobRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+// Deprecated: Use ToggleJobRequest.ProtoReflect.Descriptor instead.
+func (*ToggleJobRequest) Descriptor() ([]byte, []int) {
+	return file_spiderjob_proto_rawDescGZIP(), []int{17}
 }
 
-func (m *GetJobRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (x *ToggleJobRequest) GetJobName() string {
+	if x != nil {
+		return x.JobName
+	}
+	return ""
 }
 
-func (m *GetJobRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if len(m.JobName) > 0 {
-		i -= len(m.JobName)
-		copy(dAtA[i:], m.JobName)
-		i = encodeVarintSpiderjob(dAtA, i, uint64(len(m.JobName)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+type ToggleJobResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Job *Job `protobuf:"bytes,1,opt,name=job,proto3" json:"job,omitempty"`
 }
 
-func (m *GetJobResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (x *ToggleJobResponse) Reset() {
+	*x = ToggleJobResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_spiderjob_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return dAtA[:n], nil
 }
 
-func (m *GetJobResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (x *ToggleJobResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (m *GetJobResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if m.Job != nil {
-		{
-			size, err := m.Job.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintSpiderjob(dAtA, i, uint64(size))
+func (*ToggleJobResponse) ProtoMessage() {}
+
+func (x *ToggleJobResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_spiderjob_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		i--
-		dAtA[i] = 0xa
+		return ms
 	}
-	return len(dAtA) - i, nil
+	return mi.MessageOf(x)
 }
 
-func (m *Execution) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+// Deprecated: Use ToggleJobResponse.ProtoReflect.Descriptor instead.
+func (*ToggleJobResponse) Descriptor() ([]byte, []int) {
+	return file_spiderjob_proto_rawDescGZIP(), []int{18}
 }
 
-func (m *Execution) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (x *ToggleJobResponse) GetJob() *Job {
+	if x != nil {
+		return x.Job
+	}
+	return nil
 }
 
-func (m *Execution) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if m.FinishedAt != nil {
-		{
-			size, err := m.FinishedAt.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintSpiderjob(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x42
-	}
-	if m.StartedAt != nil {
-		{
-			size, err := m.StartedAt.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintSpiderjob(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x3a
-	}
-	if m.Attempt != 0 {
-		i = encodeVarintSpiderjob(dAtA, i, uint64(m.Attempt))
-		i--
-		dAtA[i] = 0x30
-	}
-	if m.Group != 0 {
-		i = encodeVarintSpiderjob(dAtA, i, uint64(m.Group))
-		i--
-		dAtA[i] = 0x28
-	}
-	if len(m.NodeName) > 0 {
-		i -= len(m.NodeName)
-		copy(dAtA[i:], m.NodeName)
-		i = encodeVarintSpiderjob(dAtA, i, uint64(len(m.NodeName)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.Output) > 0 {
-		i -= len(m.Output)
-		copy(dAtA[i:], m.Output)
-		i = encodeVarintSpiderjob(dAtA, i, uint64(len(m.Output)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if m.Success {
-		i--
-		if m.Success {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.JobName) > 0 {
-		i -= len(m.JobName)
-		copy(dAtA[i:], m.JobName)
-		i = encodeVarintSpiderjob(dAtA, i, uint64(len(m.JobName)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+type RaftServer struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id           string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Node         string `protobuf:"bytes,2,opt,name=node,proto3" json:"node,omitempty"`
+	Address      string `protobuf:"bytes,3,opt,name=address,proto3" json:"address,omitempty"`
+	Leader       bool   `protobuf:"varint,4,opt,name=leader,proto3" json:"leader,omitempty"`
+	Voter        bool   `protobuf:"varint,5,opt,name=voter,proto3" json:"voter,omitempty"`
+	RaftProtocol string `protobuf:"bytes,6,opt,name=raft_protocol,json=raftProtocol,proto3" json:"raft_protocol,omitempty"`
 }
 
-func (m *ExecutionDoneRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (x *RaftServer) Reset() {
+	*x = RaftServer{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_spiderjob_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return dAtA[:n], nil
 }
 
-func (m *ExecutionDoneRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (x *RaftServer) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (m *ExecutionDoneRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if m.Execution != nil {
-		{
-			size, err := m.Execution.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintSpiderjob(dAtA, i, uint64(size))
+func (*RaftServer) ProtoMessage() {}
+
+func (x *RaftServer) ProtoReflect() protoreflect.Message {
+	mi := &file_spiderjob_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		i--
-		dAtA[i] = 0xa
+		return ms
 	}
-	return len(dAtA) - i, nil
+	return mi.MessageOf(x)
 }
 
-func (m *ExecutionDoneResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+// Deprecated: Use RaftServer.ProtoReflect.Descriptor instead.
+func (*RaftServer) Descriptor() ([]byte, []int) {
+	return file_spiderjob_proto_rawDescGZIP(), []int{19}
 }
 
-func (m *ExecutionDoneResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (x *RaftServer) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
 }
 
-func (m *ExecutionDoneResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+func (x *RaftServer) GetNode() string {
+	if x != nil {
+		return x.Node
 	}
-	if len(m.Payload) > 0 {
-		i -= len(m.Payload)
-		copy(dAtA[i:], m.Payload)
-		i = encodeVarintSpiderjob(dAtA, i, uint64(len(m.Payload)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.From) > 0 {
-		i -= len(m.From)
-		copy(dAtA[i:], m.From)
-		i = encodeVarintSpiderjob(dAtA, i, uint64(len(m.From)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *RunJobRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (x *RaftServer) GetAddress() string {
+	if x != nil {
+		return x.Address
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *RunJobRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (x *RaftServer) GetLeader() bool {
+	if x != nil {
+		return x.Leader
+	}
+	return false
 }
 
-func (m *RunJobRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+func (x *RaftServer) GetVoter() bool {
+	if x != nil {
+		return x.Voter
 	}
-	if len(m.JobName) > 0 {
-		i -= len(m.JobName)
-		copy(dAtA[i:], m.JobName)
-		i = encodeVarintSpiderjob(dAtA, i, uint64(len(m.JobName)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+	return false
 }
 
-func (m *RunJobResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (x *RaftServer) GetRaftProtocol() string {
+	if x != nil {
+		return x.RaftProtocol
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *RunJobResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type RaftGetConfigurationResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Servers []*RaftServer `protobuf:"bytes,1,rep,name=servers,proto3" json:"servers,omitempty"`
+	Index   uint64        `protobuf:"varint,2,opt,name=index,proto3" json:"index,omitempty"`
 }
 
-func (m *RunJobResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if m.Job != nil {
-		{
-			size, err := m.Job.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintSpiderjob(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+func (x *RaftGetConfigurationResponse) Reset() {
+	*x = RaftGetConfigurationResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_spiderjob_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return len(dAtA) - i, nil
 }
 
-func (m *ToggleJobRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (x *RaftGetConfigurationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RaftGetConfigurationResponse) ProtoMessage() {}
+
+func (x *RaftGetConfigurationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_spiderjob_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return dAtA[:n], nil
+	return mi.MessageOf(x)
 }
 
-func (m *ToggleJobRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// Deprecated: Use RaftGetConfigurationResponse.ProtoReflect.Descriptor instead.
+func (*RaftGetConfigurationResponse) Descriptor() ([]byte, []int) {
+	return file_spiderjob_proto_rawDescGZIP(), []int{20}
 }
 
-func (m *ToggleJobRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if len(m.JobName) > 0 {
-		i -= len(m.JobName)
-		copy(dAtA[i:], m.JobName)
-		i = encodeVarintSpiderjob(dAtA, i, uint64(len(m.JobName)))
-		i--
-		dAtA[i] = 0xa
+func (x *RaftGetConfigurationResponse) GetServers() []*RaftServer {
+	if x != nil {
+		return x.Servers
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *ToggleJobResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (x *RaftGetConfigurationResponse) GetIndex() uint64 {
+	if x != nil {
+		return x.Index
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *ToggleJobResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type RaftRemovePeerByIDRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func (m *ToggleJobResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+func (x *RaftRemovePeerByIDRequest) Reset() {
+	*x = RaftRemovePeerByIDRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_spiderjob_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	if m.Job != nil {
-		{
-			size, err := m.Job.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintSpiderjob(dAtA, i, uint64(size))
+}
+
+func (x *RaftRemovePeerByIDRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RaftRemovePeerByIDRequest) ProtoMessage() {}
+
+func (x *RaftRemovePeerByIDRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_spiderjob_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		i--
-		dAtA[i] = 0xa
+		return ms
 	}
-	return len(dAtA) - i, nil
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RaftRemovePeerByIDRequest.ProtoReflect.Descriptor instead.
+func (*RaftRemovePeerByIDRequest) Descriptor() ([]byte, []int) {
+	return file_spiderjob_proto_rawDescGZIP(), []int{21}
 }
 
-func (m *RaftServer) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (x *RaftRemovePeerByIDRequest) GetId() string {
+	if x != nil {
+		return x.Id
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *RaftServer) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type AgentRunStream struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Execution *Execution `protobuf:"bytes,1,opt,name=execution,proto3" json:"execution,omitempty"`
 }
 
-func (m *RaftServer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+func (x *AgentRunStream) Reset() {
+	*x = AgentRunStream{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_spiderjob_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	if len(m.RaftProtocol) > 0 {
-		i -= len(m.RaftProtocol)
-		copy(dAtA[i:], m.RaftProtocol)
-		i = encodeVarintSpiderjob(dAtA, i, uint64(len(m.RaftProtocol)))
-		i--
-		dAtA[i] = 0x32
-	}
-	if m.Voter {
-		i--
-		if m.Voter {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x28
-	}
-	if m.Leader {
-		i--
-		if m.Leader {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x20
-	}
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintSpiderjob(dAtA, i, uint64(len(m.Address)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Node) > 0 {
-		i -= len(m.Node)
-		copy(dAtA[i:], m.Node)
-		i = encodeVarintSpiderjob(dAtA, i, uint64(len(m.Node)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Id) > 0 {
-		i -= len(m.Id)
-		copy(dAtA[i:], m.Id)
-		i = encodeVarintSpiderjob(dAtA, i, uint64(len(m.Id)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
-}
-
-func (m *RaftGetConfigurationResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
-
-func (m *RaftGetConfigurationResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *RaftGetConfigurationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if m.Index != 0 {
-		i = encodeVarintSpiderjob(dAtA, i, uint64(m.Index))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.Servers) > 0 {
-		for iNdEx := len(m.Servers) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Servers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintSpiderjob(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+}
+
+func (x *AgentRunStream) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AgentRunStream) ProtoMessage() {}
+
+func (x *AgentRunStream) ProtoReflect() protoreflect.Message {
+	mi := &file_spiderjob_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
+		return ms
 	}
-	return len(dAtA) - i, nil
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AgentRunStream.ProtoReflect.Descriptor instead.
+func (*AgentRunStream) Descriptor() ([]byte, []int) {
+	return file_spiderjob_proto_rawDescGZIP(), []int{22}
 }
 
-func (m *RaftRemovePeerByIDRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (x *AgentRunStream) GetExecution() *Execution {
+	if x != nil {
+		return x.Execution
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *RaftRemovePeerByIDRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type AgentRunResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	From    string `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	Payload []byte `protobuf:"bytes,2,opt,name=payload,proto3" json:"payload,omitempty"`
 }
 
-func (m *RaftRemovePeerByIDRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if len(m.Id) > 0 {
-		i -= len(m.Id)
-		copy(dAtA[i:], m.Id)
-		i = encodeVarintSpiderjob(dAtA, i, uint64(len(m.Id)))
-		i--
-		dAtA[i] = 0xa
+func (x *AgentRunResponse) Reset() {
+	*x = AgentRunResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_spiderjob_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return len(dAtA) - i, nil
 }
 
-func (m *AgentRunStream) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (x *AgentRunResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AgentRunResponse) ProtoMessage() {}
+
+func (x *AgentRunResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_spiderjob_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return dAtA[:n], nil
+	return mi.MessageOf(x)
 }
 
-func (m *AgentRunStream) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// Deprecated: Use AgentRunResponse.ProtoReflect.Descriptor instead.
+func (*AgentRunResponse) Descriptor() ([]byte, []int) {
+	return file_spiderjob_proto_rawDescGZIP(), []int{23}
 }
 
-func (m *AgentRunStream) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if m.Execution != nil {
-		{
-			size, err := m.Execution.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintSpiderjob(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
-}
-
-func (m *AgentRunResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
-
-func (m *AgentRunResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *AgentRunResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if len(m.Payload) > 0 {
-		i -= len(m.Payload)
-		copy(dAtA[i:], m.Payload)
-		i = encodeVarintSpiderjob(dAtA, i, uint64(len(m.Payload)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.From) > 0 {
-		i -= len(m.From)
-		copy(dAtA[i:], m.From)
-		i = encodeVarintSpiderjob(dAtA, i, uint64(len(m.From)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
-}
-
-func (m *GetActiveExecutionsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
-
-func (m *GetActiveExecutionsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *GetActiveExecutionsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if len(m.Executions) > 0 {
-		for iNdEx := len(m.Executions) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Executions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintSpiderjob(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+func (x *AgentRunResponse) GetFrom() string {
+	if x != nil {
+		return x.From
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *AgentRunRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (x *AgentRunResponse) GetPayload() []byte {
+	if x != nil {
+		return x.Payload
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *AgentRunRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type GetActiveExecutionsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Executions []*Execution `protobuf:"bytes,1,rep,name=executions,proto3" json:"executions,omitempty"`
 }
 
-func (m *AgentRunRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+func (x *GetActiveExecutionsResponse) Reset() {
+	*x = GetActiveExecutionsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_spiderjob_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	if m.Execution != nil {
-		{
-			size, err := m.Execution.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintSpiderjob(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.Job != nil {
-		{
-			size, err := m.Job.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintSpiderjob(dAtA, i, uint64(size))
+}
+
+func (x *GetActiveExecutionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetActiveExecutionsResponse) ProtoMessage() {}
+
+func (x *GetActiveExecutionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_spiderjob_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		i--
-		dAtA[i] = 0xa
+		return ms
 	}
-	return len(dAtA) - i, nil
+	return mi.MessageOf(x)
 }
 
-func encodeVarintSpiderjob(dAtA []byte, offset int, v uint64) int {
-	offset -= sovSpiderjob(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
+// Deprecated: Use GetActiveExecutionsResponse.ProtoReflect.Descriptor instead.
+func (*GetActiveExecutionsResponse) Descriptor() ([]byte, []int) {
+	return file_spiderjob_proto_rawDescGZIP(), []int{24}
 }
-func (m *Job) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovSpiderjob(uint64(l))
-	}
-	l = len(m.Timezone)
-	if l > 0 {
-		n += 1 + l + sovSpiderjob(uint64(l))
-	}
-	l = len(m.Schedule)
-	if l > 0 {
-		n += 1 + l + sovSpiderjob(uint64(l))
+
+func (x *GetActiveExecutionsResponse) GetExecutions() []*Execution {
+	if x != nil {
+		return x.Executions
 	}
-	l = len(m.Owner)
-	if l > 0 {
-		n += 1 + l + sovSpiderjob(uint64(l))
-	}
-	l = len(m.OwnerEmail)
-	if l > 0 {
-		n += 1 + l + sovSpiderjob(uint64(l))
-	}
-	if m.SuccessCount != 0 {
-		n += 1 + sovSpiderjob(uint64(m.SuccessCount))
-	}
-	if m.ErrorCount != 0 {
-		n += 1 + sovSpiderjob(uint64(m.ErrorCount))
-	}
-	if m.Disabled {
-		n += 2
-	}
-	if len(m.Tags) > 0 {
-		for k, v := range m.Tags {
-			_ = k
-			_ = v
-			mapEntrySize := 1 + len(k) + sovSpiderjob(uint64(len(k))) + 1 + len(v) + sovSpiderjob(uint64(len(v)))
-			n += mapEntrySize + 1 + sovSpiderjob(uint64(mapEntrySize))
-		}
-	}
-	if m.Retries != 0 {
-		n += 1 + sovSpiderjob(uint64(m.Retries))
+	return nil
+}
+
+type PendingRetry struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Execution *Execution             `protobuf:"bytes,1,opt,name=execution,proto3" json:"execution,omitempty"`
+	RunAt     *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=run_at,json=runAt,proto3" json:"run_at,omitempty"`
+}
+
+func (x *PendingRetry) Reset() {
+	*x = PendingRetry{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_spiderjob_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	if len(m.DependentJobs) > 0 {
-		for _, s := range m.DependentJobs {
-			l = len(s)
-			n += 1 + l + sovSpiderjob(uint64(l))
+}
+
+func (x *PendingRetry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PendingRetry) ProtoMessage() {}
+
+func (x *PendingRetry) ProtoReflect() protoreflect.Message {
+	mi := &file_spiderjob_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
+		return ms
 	}
-	l = len(m.ParentJob)
-	if l > 0 {
-		n += 1 + l + sovSpiderjob(uint64(l))
-	}
-	l = len(m.Concurrency)
-	if l > 0 {
-		n += 2 + l + sovSpiderjob(uint64(l))
-	}
-	l = len(m.Executor)
-	if l > 0 {
-		n += 2 + l + sovSpiderjob(uint64(l))
-	}
-	if len(m.ExecutorConfig) > 0 {
-		for k, v := range m.ExecutorConfig {
-			_ = k
-			_ = v
-			mapEntrySize := 1 + len(k) + sovSpiderjob(uint64(len(k))) + 1 + len(v) + sovSpiderjob(uint64(len(v)))
-			n += mapEntrySize + 2 + sovSpiderjob(uint64(mapEntrySize))
-		}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PendingRetry.ProtoReflect.Descriptor instead.
+func (*PendingRetry) Descriptor() ([]byte, []int) {
+	return file_spiderjob_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *PendingRetry) GetExecution() *Execution {
+	if x != nil {
+		return x.Execution
 	}
-	l = len(m.Status)
-	if l > 0 {
-		n += 2 + l + sovSpiderjob(uint64(l))
+	return nil
+}
+
+func (x *PendingRetry) GetRunAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.RunAt
 	}
-	if len(m.Metadata) > 0 {
-		for k, v := range m.Metadata {
-			_ = k
-			_ = v
-			mapEntrySize := 1 + len(k) + sovSpiderjob(uint64(len(k))) + 1 + len(v) + sovSpiderjob(uint64(len(v)))
-			n += mapEntrySize + 2 + sovSpiderjob(uint64(mapEntrySize))
-		}
+	return nil
+}
+
+type DeletePendingRetryRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	JobName     string `protobuf:"bytes,1,opt,name=job_name,json=jobName,proto3" json:"job_name,omitempty"`
+	ExecutionId string `protobuf:"bytes,2,opt,name=execution_id,json=executionId,proto3" json:"execution_id,omitempty"`
+}
+
+func (x *DeletePendingRetryRequest) Reset() {
+	*x = DeletePendingRetryRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_spiderjob_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	if m.Next != nil {
-		l = m.Next.Size()
-		n += 2 + l + sovSpiderjob(uint64(l))
-	}
-	l = len(m.Displayname)
-	if l > 0 {
-		n += 2 + l + sovSpiderjob(uint64(l))
-	}
-	if m.LastSuccess != nil {
-		l = m.LastSuccess.Size()
-		n += 2 + l + sovSpiderjob(uint64(l))
-	}
-	if m.LastError != nil {
-		l = m.LastError.Size()
-		n += 2 + l + sovSpiderjob(uint64(l))
-	}
-	if len(m.Processors) > 0 {
-		for k, v := range m.Processors {
-			_ = k
-			_ = v
-			l = 0
-			if v != nil {
-				l = v.Size()
-				l += 1 + sovSpiderjob(uint64(l))
-			}
-			mapEntrySize := 1 + len(k) + sovSpiderjob(uint64(len(k))) + l
-			n += mapEntrySize + 2 + sovSpiderjob(uint64(mapEntrySize))
+}
+
+func (x *DeletePendingRetryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeletePendingRetryRequest) ProtoMessage() {}
+
+func (x *DeletePendingRetryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_spiderjob_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
+		return ms
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
+	return mi.MessageOf(x)
 }
 
-func (m *Job_NullableTime) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.HasValue {
-		n += 2
-	}
-	if m.Time != nil {
-		l = m.Time.Size()
-		n += 1 + l + sovSpiderjob(uint64(l))
+// Deprecated: Use DeletePendingRetryRequest.ProtoReflect.Descriptor instead.
+func (*DeletePendingRetryRequest) Descriptor() ([]byte, []int) {
+	return file_spiderjob_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *DeletePendingRetryRequest) GetJobName() string {
+	if x != nil {
+		return x.JobName
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	return ""
+}
+
+func (x *DeletePendingRetryRequest) GetExecutionId() string {
+	if x != nil {
+		return x.ExecutionId
 	}
-	return n
+	return ""
+}
+
+type Calendar struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name  string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Dates []string `protobuf:"bytes,2,rep,name=dates,proto3" json:"dates,omitempty"`
 }
 
-func (m *PluginConfig) Size() (n int) {
-	if m == nil {
-		return 0
+func (x *Calendar) Reset() {
+	*x = Calendar{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_spiderjob_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	var l int
-	_ = l
-	if len(m.Config) > 0 {
-		for k, v := range m.Config {
-			_ = k
-			_ = v
-			mapEntrySize := 1 + len(k) + sovSpiderjob(uint64(len(k))) + 1 + len(v) + sovSpiderjob(uint64(len(v)))
-			n += mapEntrySize + 1 + sovSpiderjob(uint64(mapEntrySize))
+}
+
+func (x *Calendar) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Calendar) ProtoMessage() {}
+
+func (x *Calendar) ProtoReflect() protoreflect.Message {
+	mi := &file_spiderjob_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
+		return ms
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
+	return mi.MessageOf(x)
 }
 
-func (m *SetJobRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Job != nil {
-		l = m.Job.Size()
-		n += 1 + l + sovSpiderjob(uint64(l))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
+// Deprecated: Use Calendar.ProtoReflect.Descriptor instead.
+func (*Calendar) Descriptor() ([]byte, []int) {
+	return file_spiderjob_proto_rawDescGZIP(), []int{27}
 }
 
-func (m *SetJobResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Job != nil {
-		l = m.Job.Size()
-		n += 1 + l + sovSpiderjob(uint64(l))
+func (x *Calendar) GetName() string {
+	if x != nil {
+		return x.Name
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
+	return ""
 }
 
-func (m *DeleteJobRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.JobName)
-	if l > 0 {
-		n += 1 + l + sovSpiderjob(uint64(l))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+func (x *Calendar) GetDates() []string {
+	if x != nil {
+		return x.Dates
 	}
-	return n
+	return nil
 }
 
-func (m *DeleteJobResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Job != nil {
-		l = m.Job.Size()
-		n += 1 + l + sovSpiderjob(uint64(l))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
+type SetCalendarRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Calendar *Calendar `protobuf:"bytes,1,opt,name=calendar,proto3" json:"calendar,omitempty"`
 }
 
-func (m *GetJobRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.JobName)
-	if l > 0 {
-		n += 1 + l + sovSpiderjob(uint64(l))
+func (x *SetCalendarRequest) Reset() {
+	*x = SetCalendarRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_spiderjob_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
 }
 
-func (m *GetJobResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Job != nil {
-		l = m.Job.Size()
-		n += 1 + l + sovSpiderjob(uint64(l))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
+func (x *SetCalendarRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (m *Execution) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.JobName)
-	if l > 0 {
-		n += 1 + l + sovSpiderjob(uint64(l))
-	}
-	if m.Success {
-		n += 2
-	}
-	l = len(m.Output)
-	if l > 0 {
-		n += 1 + l + sovSpiderjob(uint64(l))
-	}
-	l = len(m.NodeName)
-	if l > 0 {
-		n += 1 + l + sovSpiderjob(uint64(l))
-	}
-	if m.Group != 0 {
-		n += 1 + sovSpiderjob(uint64(m.Group))
-	}
-	if m.Attempt != 0 {
-		n += 1 + sovSpiderjob(uint64(m.Attempt))
-	}
-	if m.StartedAt != nil {
-		l = m.StartedAt.Size()
-		n += 1 + l + sovSpiderjob(uint64(l))
-	}
-	if m.FinishedAt != nil {
-		l = m.FinishedAt.Size()
-		n += 1 + l + sovSpiderjob(uint64(l))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+func (*SetCalendarRequest) ProtoMessage() {}
+
+func (x *SetCalendarRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_spiderjob_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return n
+	return mi.MessageOf(x)
 }
 
-func (m *ExecutionDoneRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Execution != nil {
-		l = m.Execution.Size()
-		n += 1 + l + sovSpiderjob(uint64(l))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
+// Deprecated: Use SetCalendarRequest.ProtoReflect.Descriptor instead.
+func (*SetCalendarRequest) Descriptor() ([]byte, []int) {
+	return file_spiderjob_proto_rawDescGZIP(), []int{28}
 }
 
-func (m *ExecutionDoneResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (x *SetCalendarRequest) GetCalendar() *Calendar {
+	if x != nil {
+		return x.Calendar
 	}
-	var l int
-	_ = l
-	l = len(m.From)
-	if l > 0 {
-		n += 1 + l + sovSpiderjob(uint64(l))
-	}
-	l = len(m.Payload)
-	if l > 0 {
-		n += 1 + l + sovSpiderjob(uint64(l))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
+	return nil
 }
 
-func (m *RunJobRequest) Size() (n int) {
-	if m == nil {
-		return 0
+type SetCalendarResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Calendar *Calendar `protobuf:"bytes,1,opt,name=calendar,proto3" json:"calendar,omitempty"`
+}
+
+func (x *SetCalendarResponse) Reset() {
+	*x = SetCalendarResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_spiderjob_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	var l int
-	_ = l
-	l = len(m.JobName)
-	if l > 0 {
-		n += 1 + l + sovSpiderjob(uint64(l))
+}
+
+func (x *SetCalendarResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetCalendarResponse) ProtoMessage() {}
+
+func (x *SetCalendarResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_spiderjob_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetCalendarResponse.ProtoReflect.Descriptor instead.
+func (*SetCalendarResponse) Descriptor() ([]byte, []int) {
+	return file_spiderjob_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *SetCalendarResponse) GetCalendar() *Calendar {
+	if x != nil {
+		return x.Calendar
 	}
-	return n
+	return nil
+}
+
+type DeleteCalendarRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 }
 
-func (m *RunJobResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (x *DeleteCalendarRequest) Reset() {
+	*x = DeleteCalendarRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_spiderjob_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	var l int
-	_ = l
-	if m.Job != nil {
-		l = m.Job.Size()
-		n += 1 + l + sovSpiderjob(uint64(l))
+}
+
+func (x *DeleteCalendarRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteCalendarRequest) ProtoMessage() {}
+
+func (x *DeleteCalendarRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_spiderjob_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteCalendarRequest.ProtoReflect.Descriptor instead.
+func (*DeleteCalendarRequest) Descriptor() ([]byte, []int) {
+	return file_spiderjob_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *DeleteCalendarRequest) GetName() string {
+	if x != nil {
+		return x.Name
 	}
-	return n
+	return ""
 }
 
-func (m *ToggleJobRequest) Size() (n int) {
-	if m == nil {
-		return 0
+type DeleteCalendarResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Calendar *Calendar `protobuf:"bytes,1,opt,name=calendar,proto3" json:"calendar,omitempty"`
+}
+
+func (x *DeleteCalendarResponse) Reset() {
+	*x = DeleteCalendarResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_spiderjob_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	var l int
-	_ = l
-	l = len(m.JobName)
-	if l > 0 {
-		n += 1 + l + sovSpiderjob(uint64(l))
+}
+
+func (x *DeleteCalendarResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteCalendarResponse) ProtoMessage() {}
+
+func (x *DeleteCalendarResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_spiderjob_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteCalendarResponse.ProtoReflect.Descriptor instead.
+func (*DeleteCalendarResponse) Descriptor() ([]byte, []int) {
+	return file_spiderjob_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *DeleteCalendarResponse) GetCalendar() *Calendar {
+	if x != nil {
+		return x.Calendar
 	}
-	return n
+	return nil
+}
+
+type UpstreamRun struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Group      int64                  `protobuf:"varint,1,opt,name=group,proto3" json:"group,omitempty"`
+	Success    bool                   `protobuf:"varint,2,opt,name=success,proto3" json:"success,omitempty"`
+	FinishedAt *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=finished_at,json=finishedAt,proto3" json:"finished_at,omitempty"`
+	Outputs    map[string]string      `protobuf:"bytes,4,rep,name=outputs,proto3" json:"outputs,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 }
 
-func (m *ToggleJobResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (x *UpstreamRun) Reset() {
+	*x = UpstreamRun{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_spiderjob_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	var l int
-	_ = l
-	if m.Job != nil {
-		l = m.Job.Size()
-		n += 1 + l + sovSpiderjob(uint64(l))
+}
+
+func (x *UpstreamRun) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpstreamRun) ProtoMessage() {}
+
+func (x *UpstreamRun) ProtoReflect() protoreflect.Message {
+	mi := &file_spiderjob_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpstreamRun.ProtoReflect.Descriptor instead.
+func (*UpstreamRun) Descriptor() ([]byte, []int) {
+	return file_spiderjob_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *UpstreamRun) GetGroup() int64 {
+	if x != nil {
+		return x.Group
 	}
-	return n
+	return 0
 }
 
-func (m *RaftServer) Size() (n int) {
-	if m == nil {
-		return 0
+func (x *UpstreamRun) GetSuccess() bool {
+	if x != nil {
+		return x.Success
 	}
-	var l int
-	_ = l
-	l = len(m.Id)
-	if l > 0 {
-		n += 1 + l + sovSpiderjob(uint64(l))
+	return false
+}
+
+func (x *UpstreamRun) GetFinishedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.FinishedAt
 	}
-	l = len(m.Node)
-	if l > 0 {
-		n += 1 + l + sovSpiderjob(uint64(l))
+	return nil
+}
+
+func (x *UpstreamRun) GetOutputs() map[string]string {
+	if x != nil {
+		return x.Outputs
 	}
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovSpiderjob(uint64(l))
+	return nil
+}
+
+type JoinState struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	JobName    string                  `protobuf:"bytes,1,opt,name=job_name,json=jobName,proto3" json:"job_name,omitempty"`
+	Parents    map[string]*UpstreamRun `protobuf:"bytes,2,rep,name=parents,proto3" json:"parents,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	LastGroups map[string]int64        `protobuf:"bytes,3,rep,name=last_groups,json=lastGroups,proto3" json:"last_groups,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+}
+
+func (x *JoinState) Reset() {
+	*x = JoinState{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_spiderjob_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	if m.Leader {
-		n += 2
+}
+
+func (x *JoinState) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JoinState) ProtoMessage() {}
+
+func (x *JoinState) ProtoReflect() protoreflect.Message {
+	mi := &file_spiderjob_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	if m.Voter {
-		n += 2
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JoinState.ProtoReflect.Descriptor instead.
+func (*JoinState) Descriptor() ([]byte, []int) {
+	return file_spiderjob_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *JoinState) GetJobName() string {
+	if x != nil {
+		return x.JobName
 	}
-	l = len(m.RaftProtocol)
-	if l > 0 {
-		n += 1 + l + sovSpiderjob(uint64(l))
+	return ""
+}
+
+func (x *JoinState) GetParents() map[string]*UpstreamRun {
+	if x != nil {
+		return x.Parents
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	return nil
+}
+
+func (x *JoinState) GetLastGroups() map[string]int64 {
+	if x != nil {
+		return x.LastGroups
 	}
-	return n
+	return nil
+}
+
+type JoinParentRunRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	JobName  string       `protobuf:"bytes,1,opt,name=job_name,json=jobName,proto3" json:"job_name,omitempty"`
+	Parent   string       `protobuf:"bytes,2,opt,name=parent,proto3" json:"parent,omitempty"`
+	Run      *UpstreamRun `protobuf:"bytes,3,opt,name=run,proto3" json:"run,omitempty"`
+	Runnable bool         `protobuf:"varint,4,opt,name=runnable,proto3" json:"runnable,omitempty"`
 }
 
-func (m *RaftGetConfigurationResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (x *JoinParentRunRequest) Reset() {
+	*x = JoinParentRunRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_spiderjob_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	var l int
-	_ = l
-	if len(m.Servers) > 0 {
-		for _, e := range m.Servers {
-			l = e.Size()
-			n += 1 + l + sovSpiderjob(uint64(l))
+}
+
+func (x *JoinParentRunRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JoinParentRunRequest) ProtoMessage() {}
+
+func (x *JoinParentRunRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_spiderjob_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
+		return ms
 	}
-	if m.Index != 0 {
-		n += 1 + sovSpiderjob(uint64(m.Index))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
+	return mi.MessageOf(x)
 }
 
-func (m *RaftRemovePeerByIDRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Id)
-	if l > 0 {
-		n += 1 + l + sovSpiderjob(uint64(l))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+// Deprecated: Use JoinParentRunRequest.ProtoReflect.Descriptor instead.
+func (*JoinParentRunRequest) Descriptor() ([]byte, []int) {
+	return file_spiderjob_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *JoinParentRunRequest) GetJobName() string {
+	if x != nil {
+		return x.JobName
 	}
-	return n
+	return ""
 }
 
-func (m *AgentRunStream) Size() (n int) {
-	if m == nil {
-		return 0
+func (x *JoinParentRunRequest) GetParent() string {
+	if x != nil {
+		return x.Parent
 	}
-	var l int
-	_ = l
-	if m.Execution != nil {
-		l = m.Execution.Size()
-		n += 1 + l + sovSpiderjob(uint64(l))
+	return ""
+}
+
+func (x *JoinParentRunRequest) GetRun() *UpstreamRun {
+	if x != nil {
+		return x.Run
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	return nil
+}
+
+func (x *JoinParentRunRequest) GetRunnable() bool {
+	if x != nil {
+		return x.Runnable
 	}
-	return n
+	return false
+}
+
+type DeleteExecutionsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	JobName      string   `protobuf:"bytes,1,opt,name=job_name,json=jobName,proto3" json:"job_name,omitempty"`
+	ExecutionIds []string `protobuf:"bytes,2,rep,name=execution_ids,json=executionIds,proto3" json:"execution_ids,omitempty"`
 }
 
-func (m *AgentRunResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (x *DeleteExecutionsRequest) Reset() {
+	*x = DeleteExecutionsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_spiderjob_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	var l int
-	_ = l
-	l = len(m.From)
-	if l > 0 {
-		n += 1 + l + sovSpiderjob(uint64(l))
+}
+
+func (x *DeleteExecutionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteExecutionsRequest) ProtoMessage() {}
+
+func (x *DeleteExecutionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_spiderjob_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	l = len(m.Payload)
-	if l > 0 {
-		n += 1 + l + sovSpiderjob(uint64(l))
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteExecutionsRequest.ProtoReflect.Descriptor instead.
+func (*DeleteExecutionsRequest) Descriptor() ([]byte, []int) {
+	return file_spiderjob_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *DeleteExecutionsRequest) GetJobName() string {
+	if x != nil {
+		return x.JobName
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	return ""
+}
+
+func (x *DeleteExecutionsRequest) GetExecutionIds() []string {
+	if x != nil {
+		return x.ExecutionIds
 	}
-	return n
+	return nil
 }
 
-func (m *GetActiveExecutionsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+type StreamExecutionRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	JobName     string `protobuf:"bytes,1,opt,name=job_name,json=jobName,proto3" json:"job_name,omitempty"`
+	ExecutionId string `protobuf:"bytes,2,opt,name=execution_id,json=executionId,proto3" json:"execution_id,omitempty"`
+}
+
+func (x *StreamExecutionRequest) Reset() {
+	*x = StreamExecutionRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_spiderjob_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	var l int
-	_ = l
-	if len(m.Executions) > 0 {
-		for _, e := range m.Executions {
-			l = e.Size()
-			n += 1 + l + sovSpiderjob(uint64(l))
+}
+
+func (x *StreamExecutionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamExecutionRequest) ProtoMessage() {}
+
+func (x *StreamExecutionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_spiderjob_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
+		return ms
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamExecutionRequest.ProtoReflect.Descriptor instead.
+func (*StreamExecutionRequest) Descriptor() ([]byte, []int) {
+	return file_spiderjob_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *StreamExecutionRequest) GetJobName() string {
+	if x != nil {
+		return x.JobName
 	}
-	return n
+	return ""
 }
 
-func (m *AgentRunRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (x *StreamExecutionRequest) GetExecutionId() string {
+	if x != nil {
+		return x.ExecutionId
 	}
-	var l int
-	_ = l
-	if m.Job != nil {
-		l = m.Job.Size()
-		n += 1 + l + sovSpiderjob(uint64(l))
+	return ""
+}
+
+type StreamExecutionResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Execution *Execution `protobuf:"bytes,1,opt,name=execution,proto3" json:"execution,omitempty"`
+}
+
+func (x *StreamExecutionResponse) Reset() {
+	*x = StreamExecutionResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_spiderjob_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	if m.Execution != nil {
-		l = m.Execution.Size()
-		n += 1 + l + sovSpiderjob(uint64(l))
+}
+
+func (x *StreamExecutionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamExecutionResponse) ProtoMessage() {}
+
+func (x *StreamExecutionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_spiderjob_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamExecutionResponse.ProtoReflect.Descriptor instead.
+func (*StreamExecutionResponse) Descriptor() ([]byte, []int) {
+	return file_spiderjob_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *StreamExecutionResponse) GetExecution() *Execution {
+	if x != nil {
+		return x.Execution
 	}
-	return n
+	return nil
 }
 
-func sovSpiderjob(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+type AgentRunRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Job       *Job       `protobuf:"bytes,1,opt,name=job,proto3" json:"job,omitempty"`
+	Execution *Execution `protobuf:"bytes,2,opt,name=execution,proto3" json:"execution,omitempty"`
 }
-func sozSpiderjob(x uint64) (n int) {
-	return sovSpiderjob(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func (x *AgentRunRequest) Reset() {
+	*x = AgentRunRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_spiderjob_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
 }
-func (m *Job) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowSpiderjob
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Job: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Job: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowSpiderjob
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthSpiderjob
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthSpiderjob
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Name = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Timezone", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowSpiderjob
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthSpiderjob
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthSpiderjob
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Timezone = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Schedule", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowSpiderjob
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthSpiderjob
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthSpiderjob
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Schedule = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowSpiderjob
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthSpiderjob
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthSpiderjob
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Owner = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 8:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OwnerEmail", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowSpiderjob
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthSpiderjob
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthSpiderjob
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.OwnerEmail = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 9:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SuccessCount", wireType)
-			}
-			m.SuccessCount = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowSpiderjob
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.SuccessCount |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 10:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ErrorCount", wireType)
-			}
-			m.ErrorCount = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowSpiderjob
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ErrorCount |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 11:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Disabled", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowSpiderjob
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.Disabled = bool(v != 0)
-		case 12:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Tags", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowSpiderjob
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthSpiderjob
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthSpiderjob
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Tags == nil {
-				m.Tags = make(map[string]string)
-			}
-			var mapkey string
-			var mapvalue string
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowSpiderjob
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowSpiderjob
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthSpiderjob
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthSpiderjob
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var stringLenmapvalue uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowSpiderjob
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapvalue |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapvalue := int(stringLenmapvalue)
-					if intStringLenmapvalue < 0 {
-						return ErrInvalidLengthSpiderjob
-					}
-					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
-					if postStringIndexmapvalue < 0 {
-						return ErrInvalidLengthSpiderjob
-					}
-					if postStringIndexmapvalue > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
-					iNdEx = postStringIndexmapvalue
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipSpiderjob(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthSpiderjob
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
-			}
-			m.Tags[mapkey] = mapvalue
-			iNdEx = postIndex
-		case 13:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Retries", wireType)
-			}
-			m.Retries = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowSpiderjob
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Retries |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 14:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DependentJobs", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowSpiderjob
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthSpiderjob
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthSpiderjob
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.DependentJobs = append(m.DependentJobs, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
-		case 15:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ParentJob", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowSpiderjob
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthSpiderjob
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthSpiderjob
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ParentJob = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 16:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Concurrency", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowSpiderjob
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthSpiderjob
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthSpiderjob
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Concurrency = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 17:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Executor", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowSpiderjob
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthSpiderjob
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthSpiderjob
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Executor = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 18:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExecutorConfig", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowSpiderjob
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthSpiderjob
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthSpiderjob
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.ExecutorConfig == nil {
-				m.ExecutorConfig = make(map[string]string)
-			}
-			var mapkey string
-			var mapvalue string
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowSpiderjob
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowSpiderjob
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthSpiderjob
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthSpiderjob
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var stringLenmapvalue uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowSpiderjob
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapvalue |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapvalue := int(stringLenmapvalue)
-					if intStringLenmapvalue < 0 {
-						return ErrInvalidLengthSpiderjob
-					}
-					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
-					if postStringIndexmapvalue < 0 {
-						return ErrInvalidLengthSpiderjob
-					}
-					if postStringIndexmapvalue > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
-					iNdEx = postStringIndexmapvalue
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipSpiderjob(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthSpiderjob
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
-			}
-			m.ExecutorConfig[mapkey] = mapvalue
-			iNdEx = postIndex
-		case 19:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowSpiderjob
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthSpiderjob
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthSpiderjob
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Status = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 20:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowSpiderjob
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthSpiderjob
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthSpiderjob
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Metadata == nil {
-				m.Metadata = make(map[string]string)
-			}
-			var mapkey string
-			var mapvalue string
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowSpiderjob
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowSpiderjob
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthSpiderjob
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthSpiderjob
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var stringLenmapvalue uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowSpiderjob
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapvalue |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapvalue := int(stringLenmapvalue)
-					if intStringLenmapvalue < 0 {
-						return ErrInvalidLengthSpiderjob
-					}
-					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
-					if postStringIndexmapvalue < 0 {
-						return ErrInvalidLengthSpiderjob
-					}
-					if postStringIndexmapvalue > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
-					iNdEx = postStringIndexmapvalue
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipSpiderjob(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthSpiderjob
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
-			}
-			m.Metadata[mapkey] = mapvalue
-			iNdEx = postIndex
-		case 23:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Next", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowSpiderjob
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthSpiderjob
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthSpiderjob
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Next == nil {
-				m.Next = &protobuf.Timestamp{}
-			}
-			if err := m.Next.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 24:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Displayname", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowSpiderjob
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthSpiderjob
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthSpiderjob
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Displayname = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 25:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastSuccess", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowSpiderjob
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthSpiderjob
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthSpiderjob
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.LastSuccess == nil {
-				m.LastSuccess = &Job_NullableTime{}
-			}
-			if err := m.LastSuccess.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 26:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastError", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowSpiderjob
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthSpiderjob
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthSpiderjob
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.LastError == nil {
-				m.LastError = &Job_NullableTime{}
-			}
-			if err := m.LastError.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 27:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Processors", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowSpiderjob
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthSpiderjob
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthSpiderjob
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Processors == nil {
-				m.Processors = make(map[string]*PluginConfig)
-			}
-			var mapkey string
-			var mapvalue *PluginConfig
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowSpiderjob
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowSpiderjob
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthSpiderjob
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthSpiderjob
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var mapmsglen int
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowSpiderjob
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						mapmsglen |= int(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					if mapmsglen < 0 {
-						return ErrInvalidLengthSpiderjob
-					}
-					postmsgIndex := iNdEx + mapmsglen
-					if postmsgIndex < 0 {
-						return ErrInvalidLengthSpiderjob
-					}
-					if postmsgIndex > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = &PluginConfig{}
-					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
-						return err
-					}
-					iNdEx = postmsgIndex
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipSpiderjob(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthSpiderjob
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
-			}
-			m.Processors[mapkey] = mapvalue
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipSpiderjob(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthSpiderjob
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
 
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
+func (x *AgentRunRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
-func (m *Job_NullableTime) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowSpiderjob
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: NullableTime: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: NullableTime: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HasValue", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowSpiderjob
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.HasValue = bool(v != 0)
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowSpiderjob
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthSpiderjob
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthSpiderjob
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Time == nil {
-				m.Time = &protobuf.Timestamp{}
-			}
-			if err := m.Time.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipSpiderjob(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthSpiderjob
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
+
+func (*AgentRunRequest) ProtoMessage() {}
+
+func (x *AgentRunRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_spiderjob_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
+		return ms
 	}
+	return mi.MessageOf(x)
+}
 
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
+// Deprecated: Use AgentRunRequest.ProtoReflect.Descriptor instead.
+func (*AgentRunRequest) Descriptor() ([]byte, []int) {
+	return file_spiderjob_proto_rawDescGZIP(), []int{38}
 }
-func (m *PluginConfig) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowSpiderjob
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: PluginConfig: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PluginConfig: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Config", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowSpiderjob
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthSpiderjob
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthSpiderjob
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Config == nil {
-				m.Config = make(map[string]string)
-			}
-			var mapkey string
-			var mapvalue string
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowSpiderjob
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowSpiderjob
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthSpiderjob
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthSpiderjob
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var stringLenmapvalue uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowSpiderjob
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapvalue |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapvalue := int(stringLenmapvalue)
-					if intStringLenmapvalue < 0 {
-						return ErrInvalidLengthSpiderjob
-					}
-					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
-					if postStringIndexmapvalue < 0 {
-						return ErrInvalidLengthSpiderjob
-					}
-					if postStringIndexmapvalue > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
-					iNdEx = postStringIndexmapvalue
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipSpiderjob(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthSpiderjob
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
-			}
-			m.Config[mapkey] = mapvalue
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipSpiderjob(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthSpiderjob
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
 
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
+func (x *AgentRunRequest) GetJob() *Job {
+	if x != nil {
+		return x.Job
 	}
 	return nil
 }
-func (m *SetJobRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowSpiderjob
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: SetJobRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SetJobRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Job", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowSpiderjob
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthSpiderjob
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthSpiderjob
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Job == nil {
-				m.Job = &Job{}
-			}
-			if err := m.Job.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipSpiderjob(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthSpiderjob
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
 
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
+func (x *AgentRunRequest) GetExecution() *Execution {
+	if x != nil {
+		return x.Execution
 	}
 	return nil
 }
-func (m *SetJobResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowSpiderjob
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: SetJobResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SetJobResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Job", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowSpiderjob
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthSpiderjob
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthSpiderjob
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Job == nil {
-				m.Job = &Job{}
-			}
-			if err := m.Job.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipSpiderjob(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthSpiderjob
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
 
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
+type StopExecutionRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	JobName     string `protobuf:"bytes,1,opt,name=job_name,json=jobName,proto3" json:"job_name,omitempty"`
+	ExecutionId string `protobuf:"bytes,2,opt,name=execution_id,json=executionId,proto3" json:"execution_id,omitempty"`
+	StoppedBy   string `protobuf:"bytes,3,opt,name=stopped_by,json=stoppedBy,proto3" json:"stopped_by,omitempty"`
 }
-func (m *DeleteJobRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowSpiderjob
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: DeleteJobRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DeleteJobRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JobName", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowSpiderjob
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthSpiderjob
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthSpiderjob
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.JobName = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipSpiderjob(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthSpiderjob
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
 
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
+func (x *StopExecutionRequest) Reset() {
+	*x = StopExecutionRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_spiderjob_proto_msgTypes[39]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return nil
 }
-func (m *DeleteJobResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowSpiderjob
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: DeleteJobResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DeleteJobResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Job", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowSpiderjob
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthSpiderjob
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthSpiderjob
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Job == nil {
-				m.Job = &Job{}
-			}
-			if err := m.Job.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipSpiderjob(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthSpiderjob
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
 
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
+func (x *StopExecutionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
-func (m *GetJobRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowSpiderjob
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: GetJobRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetJobRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JobName", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowSpiderjob
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthSpiderjob
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthSpiderjob
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.JobName = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipSpiderjob(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthSpiderjob
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
+
+func (*StopExecutionRequest) ProtoMessage() {}
+
+func (x *StopExecutionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_spiderjob_proto_msgTypes[39]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
+		return ms
 	}
+	return mi.MessageOf(x)
+}
 
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
+// Deprecated: Use StopExecutionRequest.ProtoReflect.Descriptor instead.
+func (*StopExecutionRequest) Descriptor() ([]byte, []int) {
+	return file_spiderjob_proto_rawDescGZIP(), []int{39}
 }
-func (m *GetJobResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowSpiderjob
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: GetJobResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetJobResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Job", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowSpiderjob
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthSpiderjob
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthSpiderjob
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Job == nil {
-				m.Job = &Job{}
-			}
-			if err := m.Job.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipSpiderjob(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthSpiderjob
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
 
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
+func (x *StopExecutionRequest) GetJobName() string {
+	if x != nil {
+		return x.JobName
 	}
-	return nil
+	return ""
 }
-func (m *Execution) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowSpiderjob
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Execution: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Execution: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JobName", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowSpiderjob
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthSpiderjob
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthSpiderjob
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.JobName = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Success", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowSpiderjob
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.Success = bool(v != 0)
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Output", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowSpiderjob
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if byteLen < 0 {
-				return ErrInvalidLengthSpiderjob
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthSpiderjob
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Output = append(m.Output[:0], dAtA[iNdEx:postIndex]...)
-			if m.Output == nil {
-				m.Output = []byte{}
-			}
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NodeName", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowSpiderjob
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthSpiderjob
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthSpiderjob
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.NodeName = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Group", wireType)
-			}
-			m.Group = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowSpiderjob
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Group |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Attempt", wireType)
-			}
-			m.Attempt = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowSpiderjob
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Attempt |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartedAt", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowSpiderjob
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthSpiderjob
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthSpiderjob
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.StartedAt == nil {
-				m.StartedAt = &protobuf.Timestamp{}
-			}
-			if err := m.StartedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 8:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FinishedAt", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowSpiderjob
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthSpiderjob
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthSpiderjob
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.FinishedAt == nil {
-				m.FinishedAt = &protobuf.Timestamp{}
-			}
-			if err := m.FinishedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipSpiderjob(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthSpiderjob
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
 
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
+func (x *StopExecutionRequest) GetExecutionId() string {
+	if x != nil {
+		return x.ExecutionId
 	}
-	return nil
+	return ""
 }
-func (m *ExecutionDoneRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowSpiderjob
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ExecutionDoneRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ExecutionDoneRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Execution", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowSpiderjob
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthSpiderjob
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthSpiderjob
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Execution == nil {
-				m.Execution = &Execution{}
-			}
-			if err := m.Execution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipSpiderjob(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthSpiderjob
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
+
+func (x *StopExecutionRequest) GetStoppedBy() string {
+	if x != nil {
+		return x.StoppedBy
 	}
+	return ""
+}
 
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
+type StopExecutionResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	From string `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+}
+
+func (x *StopExecutionResponse) Reset() {
+	*x = StopExecutionResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_spiderjob_proto_msgTypes[40]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return nil
 }
-func (m *ExecutionDoneResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowSpiderjob
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ExecutionDoneResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ExecutionDoneResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowSpiderjob
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthSpiderjob
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthSpiderjob
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.From = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Payload", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowSpiderjob
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if byteLen < 0 {
-				return ErrInvalidLengthSpiderjob
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthSpiderjob
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Payload = append(m.Payload[:0], dAtA[iNdEx:postIndex]...)
-			if m.Payload == nil {
-				m.Payload = []byte{}
-			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipSpiderjob(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthSpiderjob
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
+
+func (x *StopExecutionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StopExecutionResponse) ProtoMessage() {}
+
+func (x *StopExecutionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_spiderjob_proto_msgTypes[40]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
+		return ms
 	}
+	return mi.MessageOf(x)
+}
 
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
+// Deprecated: Use StopExecutionResponse.ProtoReflect.Descriptor instead.
+func (*StopExecutionResponse) Descriptor() ([]byte, []int) {
+	return file_spiderjob_proto_rawDescGZIP(), []int{40}
 }
-func (m *RunJobRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowSpiderjob
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: RunJobRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RunJobRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JobName", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowSpiderjob
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthSpiderjob
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthSpiderjob
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.JobName = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipSpiderjob(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthSpiderjob
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
+
+func (x *StopExecutionResponse) GetFrom() string {
+	if x != nil {
+		return x.From
 	}
+	return ""
+}
+
+type GetExecutionLogRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	JobName     string `protobuf:"bytes,1,opt,name=job_name,json=jobName,proto3" json:"job_name,omitempty"`
+	ExecutionId string `protobuf:"bytes,2,opt,name=execution_id,json=executionId,proto3" json:"execution_id,omitempty"`
+	// Byte offset to start reading from, negative values count from the end.
+	Offset int64 `protobuf:"varint,3,opt,name=offset,proto3" json:"offset,omitempty"`
+	Limit  int64 `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
+}
 
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
+func (x *GetExecutionLogRequest) Reset() {
+	*x = GetExecutionLogRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_spiderjob_proto_msgTypes[41]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return nil
 }
-func (m *RunJobResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowSpiderjob
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: RunJobResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RunJobResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Job", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowSpiderjob
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthSpiderjob
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthSpiderjob
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Job == nil {
-				m.Job = &Job{}
-			}
-			if err := m.Job.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipSpiderjob(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthSpiderjob
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
+
+func (x *GetExecutionLogRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetExecutionLogRequest) ProtoMessage() {}
+
+func (x *GetExecutionLogRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_spiderjob_proto_msgTypes[41]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
+		return ms
 	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetExecutionLogRequest.ProtoReflect.Descriptor instead.
+func (*GetExecutionLogRequest) Descriptor() ([]byte, []int) {
+	return file_spiderjob_proto_rawDescGZIP(), []int{41}
+}
 
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
+func (x *GetExecutionLogRequest) GetJobName() string {
+	if x != nil {
+		return x.JobName
 	}
-	return nil
+	return ""
 }
-func (m *ToggleJobRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowSpiderjob
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ToggleJobRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ToggleJobRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JobName", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowSpiderjob
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthSpiderjob
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthSpiderjob
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.JobName = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipSpiderjob(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthSpiderjob
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
+
+func (x *GetExecutionLogRequest) GetExecutionId() string {
+	if x != nil {
+		return x.ExecutionId
 	}
+	return ""
+}
 
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
+func (x *GetExecutionLogRequest) GetOffset() int64 {
+	if x != nil {
+		return x.Offset
 	}
-	return nil
+	return 0
 }
-func (m *ToggleJobResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowSpiderjob
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ToggleJobResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ToggleJobResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Job", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowSpiderjob
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthSpiderjob
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthSpiderjob
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Job == nil {
-				m.Job = &Job{}
-			}
-			if err := m.Job.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipSpiderjob(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthSpiderjob
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
+
+func (x *GetExecutionLogRequest) GetLimit() int64 {
+	if x != nil {
+		return x.Limit
 	}
+	return 0
+}
+
+type GetExecutionLogResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
+	Data       []byte `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	Offset     int64  `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
+	NextOffset int64  `protobuf:"varint,3,opt,name=next_offset,json=nextOffset,proto3" json:"next_offset,omitempty"`
+	Size       int64  `protobuf:"varint,4,opt,name=size,proto3" json:"size,omitempty"`
+}
+
+func (x *GetExecutionLogResponse) Reset() {
+	*x = GetExecutionLogResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_spiderjob_proto_msgTypes[42]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return nil
 }
-func (m *RaftServer) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowSpiderjob
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: RaftServer: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RaftServer: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowSpiderjob
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthSpiderjob
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthSpiderjob
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Id = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Node", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowSpiderjob
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthSpiderjob
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthSpiderjob
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Node = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowSpiderjob
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthSpiderjob
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthSpiderjob
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Address = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Leader", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowSpiderjob
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.Leader = bool(v != 0)
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Voter", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowSpiderjob
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.Voter = bool(v != 0)
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RaftProtocol", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowSpiderjob
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthSpiderjob
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthSpiderjob
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.RaftProtocol = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipSpiderjob(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthSpiderjob
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
+
+func (x *GetExecutionLogResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetExecutionLogResponse) ProtoMessage() {}
+
+func (x *GetExecutionLogResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_spiderjob_proto_msgTypes[42]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
+		return ms
 	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetExecutionLogResponse.ProtoReflect.Descriptor instead.
+func (*GetExecutionLogResponse) Descriptor() ([]byte, []int) {
+	return file_spiderjob_proto_rawDescGZIP(), []int{42}
+}
 
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
+func (x *GetExecutionLogResponse) GetData() []byte {
+	if x != nil {
+		return x.Data
 	}
 	return nil
 }
-func (m *RaftGetConfigurationResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowSpiderjob
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: RaftGetConfigurationResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RaftGetConfigurationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Servers", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowSpiderjob
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthSpiderjob
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthSpiderjob
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Servers = append(m.Servers, &RaftServer{})
-			if err := m.Servers[len(m.Servers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
-			}
-			m.Index = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowSpiderjob
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Index |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipSpiderjob(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthSpiderjob
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
+
+func (x *GetExecutionLogResponse) GetOffset() int64 {
+	if x != nil {
+		return x.Offset
 	}
+	return 0
+}
 
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
+func (x *GetExecutionLogResponse) GetNextOffset() int64 {
+	if x != nil {
+		return x.NextOffset
 	}
-	return nil
+	return 0
 }
-func (m *RaftRemovePeerByIDRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowSpiderjob
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: RaftRemovePeerByIDRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RaftRemovePeerByIDRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowSpiderjob
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthSpiderjob
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthSpiderjob
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Id = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipSpiderjob(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthSpiderjob
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
+
+func (x *GetExecutionLogResponse) GetSize() int64 {
+	if x != nil {
+		return x.Size
 	}
+	return 0
+}
 
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
+type DeleteExecutionLogsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	JobName string `protobuf:"bytes,1,opt,name=job_name,json=jobName,proto3" json:"job_name,omitempty"`
+	// Executions to delete the logs of, every log of the job when empty.
+	ExecutionIds []string `protobuf:"bytes,2,rep,name=execution_ids,json=executionIds,proto3" json:"execution_ids,omitempty"`
+}
+
+func (x *DeleteExecutionLogsRequest) Reset() {
+	*x = DeleteExecutionLogsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_spiderjob_proto_msgTypes[43]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return nil
 }
-func (m *AgentRunStream) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowSpiderjob
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: AgentRunStream: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AgentRunStream: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Execution", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowSpiderjob
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthSpiderjob
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthSpiderjob
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Execution == nil {
-				m.Execution = &Execution{}
-			}
-			if err := m.Execution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipSpiderjob(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthSpiderjob
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
+
+func (x *DeleteExecutionLogsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteExecutionLogsRequest) ProtoMessage() {}
+
+func (x *DeleteExecutionLogsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_spiderjob_proto_msgTypes[43]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
+		return ms
 	}
+	return mi.MessageOf(x)
+}
 
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
+// Deprecated: Use DeleteExecutionLogsRequest.ProtoReflect.Descriptor instead.
+func (*DeleteExecutionLogsRequest) Descriptor() ([]byte, []int) {
+	return file_spiderjob_proto_rawDescGZIP(), []int{43}
 }
-func (m *AgentRunResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowSpiderjob
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: AgentRunResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AgentRunResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowSpiderjob
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthSpiderjob
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthSpiderjob
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.From = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Payload", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowSpiderjob
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if byteLen < 0 {
-				return ErrInvalidLengthSpiderjob
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthSpiderjob
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Payload = append(m.Payload[:0], dAtA[iNdEx:postIndex]...)
-			if m.Payload == nil {
-				m.Payload = []byte{}
-			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipSpiderjob(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthSpiderjob
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
+
+func (x *DeleteExecutionLogsRequest) GetJobName() string {
+	if x != nil {
+		return x.JobName
 	}
+	return ""
+}
 
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
+func (x *DeleteExecutionLogsRequest) GetExecutionIds() []string {
+	if x != nil {
+		return x.ExecutionIds
 	}
 	return nil
 }
-func (m *GetActiveExecutionsResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowSpiderjob
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: GetActiveExecutionsResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetActiveExecutionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Executions", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowSpiderjob
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthSpiderjob
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthSpiderjob
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Executions = append(m.Executions, &Execution{})
-			if err := m.Executions[len(m.Executions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipSpiderjob(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthSpiderjob
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
 
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
+type Job_NullableTime struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	HasValue bool                   `protobuf:"varint,1,opt,name=has_value,json=hasValue,proto3" json:"has_value,omitempty"`
+	Time     *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=time,proto3" json:"time,omitempty"`
+}
+
+func (x *Job_NullableTime) Reset() {
+	*x = Job_NullableTime{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_spiderjob_proto_msgTypes[47]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return nil
 }
-func (m *AgentRunRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowSpiderjob
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: AgentRunRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AgentRunRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Job", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowSpiderjob
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthSpiderjob
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthSpiderjob
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Job == nil {
-				m.Job = &Job{}
-			}
-			if err := m.Job.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Execution", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowSpiderjob
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthSpiderjob
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthSpiderjob
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Execution == nil {
-				m.Execution = &Execution{}
-			}
-			if err := m.Execution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipSpiderjob(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthSpiderjob
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
+
+func (x *Job_NullableTime) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Job_NullableTime) ProtoMessage() {}
+
+func (x *Job_NullableTime) ProtoReflect() protoreflect.Message {
+	mi := &file_spiderjob_proto_msgTypes[47]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
+		return ms
 	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Job_NullableTime.ProtoReflect.Descriptor instead.
+func (*Job_NullableTime) Descriptor() ([]byte, []int) {
+	return file_spiderjob_proto_rawDescGZIP(), []int{0, 3}
+}
 
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
+func (x *Job_NullableTime) GetHasValue() bool {
+	if x != nil {
+		return x.HasValue
 	}
-	return nil
+	return false
 }
-func skipSpiderjob(dAtA []byte) (n int, err error) {
-	l := len(dAtA)
-	iNdEx := 0
-	depth := 0
-	for iNdEx < l {
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return 0, ErrIntOverflowSpiderjob
-			}
-			if iNdEx >= l {
-				return 0, io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		wireType := int(wire & 0x7)
-		switch wireType {
-		case 0:
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return 0, ErrIntOverflowSpiderjob
-				}
-				if iNdEx >= l {
-					return 0, io.ErrUnexpectedEOF
-				}
-				iNdEx++
-				if dAtA[iNdEx-1] < 0x80 {
-					break
-				}
-			}
-		case 1:
-			iNdEx += 8
-		case 2:
-			var length int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return 0, ErrIntOverflowSpiderjob
-				}
-				if iNdEx >= l {
-					return 0, io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				length |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if length < 0 {
-				return 0, ErrInvalidLengthSpiderjob
-			}
-			iNdEx += length
-		case 3:
-			depth++
-		case 4:
-			if depth == 0 {
-				return 0, ErrUnexpectedEndOfGroupSpiderjob
-			}
-			depth--
-		case 5:
-			iNdEx += 4
-		default:
-			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
-		}
-		if iNdEx < 0 {
-			return 0, ErrInvalidLengthSpiderjob
-		}
-		if depth == 0 {
-			return iNdEx, nil
-		}
+
+func (x *Job_NullableTime) GetTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Time
 	}
-	return 0, io.ErrUnexpectedEOF
+	return nil
+}
+
+var File_spiderjob_proto protoreflect.FileDescriptor
+
+var file_spiderjob_proto_rawDesc = []byte{
+	0x0a, 0x0f, 0x73, 0x70, 0x69, 0x64, 0x65, 0x72, 0x6a, 0x6f, 0x62, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x12, 0x05, 0x74, 0x79, 0x70, 0x65, 0x73, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1b, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x65, 0x6d, 0x70, 0x74, 0x79,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xda, 0x0c, 0x0a, 0x03, 0x4a, 0x6f, 0x62, 0x12, 0x12,
+	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x74, 0x69, 0x6d, 0x65, 0x7a, 0x6f, 0x6e, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x69, 0x6d, 0x65, 0x7a, 0x6f, 0x6e, 0x65, 0x12, 0x1a,
+	0x0a, 0x08, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6f, 0x77,
+	0x6e, 0x65, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72,
+	0x12, 0x1f, 0x0a, 0x0b, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x5f, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x45, 0x6d, 0x61, 0x69,
+	0x6c, 0x12, 0x23, 0x0a, 0x0d, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x5f, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73,
+	0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x65, 0x72, 0x72,
+	0x6f, 0x72, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x69, 0x73, 0x61, 0x62,
+	0x6c, 0x65, 0x64, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x64, 0x69, 0x73, 0x61, 0x62,
+	0x6c, 0x65, 0x64, 0x12, 0x28, 0x0a, 0x04, 0x74, 0x61, 0x67, 0x73, 0x18, 0x0c, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x14, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x4a, 0x6f, 0x62, 0x2e, 0x54, 0x61,
+	0x67, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x04, 0x74, 0x61, 0x67, 0x73, 0x12, 0x18, 0x0a,
+	0x07, 0x72, 0x65, 0x74, 0x72, 0x69, 0x65, 0x73, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07,
+	0x72, 0x65, 0x74, 0x72, 0x69, 0x65, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x64, 0x65, 0x70, 0x65, 0x6e,
+	0x64, 0x65, 0x6e, 0x74, 0x5f, 0x6a, 0x6f, 0x62, 0x73, 0x18, 0x0e, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x0d, 0x64, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x74, 0x4a, 0x6f, 0x62, 0x73, 0x12, 0x1d,
+	0x0a, 0x0a, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x6a, 0x6f, 0x62, 0x18, 0x0f, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x4a, 0x6f, 0x62, 0x12, 0x20, 0x0a,
+	0x0b, 0x63, 0x6f, 0x6e, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x18, 0x10, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x12,
+	0x1a, 0x0a, 0x08, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x6f, 0x72, 0x18, 0x11, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x6f, 0x72, 0x12, 0x47, 0x0a, 0x0f, 0x65,
+	0x78, 0x65, 0x63, 0x75, 0x74, 0x6f, 0x72, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x12,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x4a, 0x6f, 0x62,
+	0x2e, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x6f, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x52, 0x0e, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x6f, 0x72, 0x43, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x13,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x34, 0x0a, 0x08,
+	0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x14, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18,
+	0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x4a, 0x6f, 0x62, 0x2e, 0x4d, 0x65, 0x74, 0x61, 0x64,
+	0x61, 0x74, 0x61, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61,
+	0x74, 0x61, 0x12, 0x3a, 0x0a, 0x0c, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x73, 0x75, 0x63, 0x63, 0x65,
+	0x73, 0x73, 0x18, 0x19, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73,
+	0x2e, 0x4a, 0x6f, 0x62, 0x2e, 0x4e, 0x75, 0x6c, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x54, 0x69, 0x6d,
+	0x65, 0x52, 0x0b, 0x6c, 0x61, 0x73, 0x74, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x36,
+	0x0a, 0x0a, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x1a, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x17, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x4a, 0x6f, 0x62, 0x2e, 0x4e,
+	0x75, 0x6c, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x52, 0x09, 0x6c, 0x61, 0x73,
+	0x74, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x2e, 0x0a, 0x04, 0x6e, 0x65, 0x78, 0x74, 0x18, 0x17,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x52, 0x04, 0x6e, 0x65, 0x78, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61,
+	0x79, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x18, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x69, 0x73,
+	0x70, 0x6c, 0x61, 0x79, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x3a, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x63,
+	0x65, 0x73, 0x73, 0x6f, 0x72, 0x73, 0x18, 0x1b, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x74,
+	0x79, 0x70, 0x65, 0x73, 0x2e, 0x4a, 0x6f, 0x62, 0x2e, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73,
+	0x6f, 0x72, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0a, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73,
+	0x73, 0x6f, 0x72, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18,
+	0x1c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x12, 0x34,
+	0x0a, 0x09, 0x72, 0x65, 0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x1d, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x16, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x74, 0x65, 0x6e, 0x74,
+	0x69, 0x6f, 0x6e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x09, 0x72, 0x65, 0x74, 0x65, 0x6e,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x35, 0x0a, 0x0c, 0x72, 0x65, 0x74, 0x72, 0x79, 0x5f, 0x70, 0x6f,
+	0x6c, 0x69, 0x63, 0x79, 0x18, 0x1e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x74, 0x79, 0x70,
+	0x65, 0x73, 0x2e, 0x52, 0x65, 0x74, 0x72, 0x79, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x0b,
+	0x72, 0x65, 0x74, 0x72, 0x79, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x27, 0x0a, 0x0f, 0x72,
+	0x65, 0x74, 0x72, 0x79, 0x5f, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x1f,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x72, 0x65, 0x74, 0x72, 0x79, 0x50, 0x6c, 0x61, 0x63, 0x65,
+	0x6d, 0x65, 0x6e, 0x74, 0x12, 0x42, 0x0a, 0x11, 0x6d, 0x69, 0x73, 0x73, 0x65, 0x64, 0x5f, 0x72,
+	0x75, 0x6e, 0x5f, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x18, 0x20, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x16, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x4d, 0x69, 0x73, 0x73, 0x65, 0x64, 0x52, 0x75,
+	0x6e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x0f, 0x6d, 0x69, 0x73, 0x73, 0x65, 0x64, 0x52,
+	0x75, 0x6e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x61, 0x6c, 0x65,
+	0x6e, 0x64, 0x61, 0x72, 0x73, 0x18, 0x21, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x63, 0x61, 0x6c,
+	0x65, 0x6e, 0x64, 0x61, 0x72, 0x73, 0x12, 0x3a, 0x0a, 0x0f, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65,
+	0x64, 0x5f, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x73, 0x18, 0x22, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x11, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x57, 0x69, 0x6e, 0x64,
+	0x6f, 0x77, 0x52, 0x0e, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x57, 0x69, 0x6e, 0x64, 0x6f,
+	0x77, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x6a, 0x6f, 0x62,
+	0x73, 0x18, 0x23, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x4a,
+	0x6f, 0x62, 0x73, 0x12, 0x15, 0x0a, 0x06, 0x66, 0x61, 0x6e, 0x5f, 0x69, 0x6e, 0x18, 0x24, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x66, 0x61, 0x6e, 0x49, 0x6e, 0x12, 0x1d, 0x0a, 0x0a, 0x74, 0x72,
+	0x69, 0x67, 0x67, 0x65, 0x72, 0x5f, 0x6f, 0x6e, 0x18, 0x25, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x74, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x4f, 0x6e, 0x1a, 0x37, 0x0a, 0x09, 0x54, 0x61, 0x67,
+	0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02,
+	0x38, 0x01, 0x1a, 0x41, 0x0a, 0x13, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x6f, 0x72, 0x43, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x3b, 0x0a, 0x0d, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74,
+	0x61, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02,
+	0x38, 0x01, 0x1a, 0x5b, 0x0a, 0x0c, 0x4e, 0x75, 0x6c, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x54, 0x69,
+	0x6d, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x68, 0x61, 0x73, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x68, 0x61, 0x73, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12,
+	0x2e, 0x0a, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x1a,
+	0x52, 0x0a, 0x0f, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x6f, 0x72, 0x73, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x03, 0x6b, 0x65, 0x79, 0x12, 0x29, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x50, 0x6c, 0x75, 0x67,
+	0x69, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a,
+	0x02, 0x38, 0x01, 0x22, 0x48, 0x0a, 0x0a, 0x54, 0x69, 0x6d, 0x65, 0x57, 0x69, 0x6e, 0x64, 0x6f,
+	0x77, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x79, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x04, 0x64, 0x61, 0x79, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x65,
+	0x6e, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x65, 0x6e, 0x64, 0x22, 0x5e, 0x0a,
+	0x0f, 0x4d, 0x69, 0x73, 0x73, 0x65, 0x64, 0x52, 0x75, 0x6e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79,
+	0x12, 0x12, 0x0a, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x6d, 0x6f, 0x64, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x67, 0x72,
+	0x61, 0x63, 0x65, 0x5f, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0b, 0x67, 0x72, 0x61, 0x63, 0x65, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x22, 0x8d, 0x01,
+	0x0a, 0x0b, 0x52, 0x65, 0x74, 0x72, 0x79, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x18, 0x0a,
+	0x07, 0x62, 0x61, 0x63, 0x6b, 0x6f, 0x66, 0x66, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x62, 0x61, 0x63, 0x6b, 0x6f, 0x66, 0x66, 0x12, 0x14, 0x0a, 0x05, 0x64, 0x65, 0x6c, 0x61, 0x79,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x64, 0x65, 0x6c, 0x61, 0x79, 0x12, 0x1b, 0x0a,
+	0x09, 0x6d, 0x61, 0x78, 0x5f, 0x64, 0x65, 0x6c, 0x61, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x6d, 0x61, 0x78, 0x44, 0x65, 0x6c, 0x61, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x6a, 0x69,
+	0x74, 0x74, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x06, 0x6a, 0x69, 0x74, 0x74,
+	0x65, 0x72, 0x12, 0x19, 0x0a, 0x08, 0x72, 0x65, 0x74, 0x72, 0x79, 0x5f, 0x6f, 0x6e, 0x18, 0x05,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x72, 0x65, 0x74, 0x72, 0x79, 0x4f, 0x6e, 0x22, 0xa9, 0x01,
+	0x0a, 0x0f, 0x52, 0x65, 0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x6f, 0x6c, 0x69, 0x63,
+	0x79, 0x12, 0x25, 0x0a, 0x0e, 0x6d, 0x61, 0x78, 0x5f, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x6d, 0x61, 0x78, 0x45, 0x78,
+	0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x17, 0x0a, 0x07, 0x6d, 0x61, 0x78, 0x5f,
+	0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6d, 0x61, 0x78, 0x41, 0x67,
+	0x65, 0x12, 0x2a, 0x0a, 0x11, 0x6b, 0x65, 0x65, 0x70, 0x5f, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x66,
+	0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x6b, 0x65,
+	0x65, 0x70, 0x4c, 0x61, 0x73, 0x74, 0x46, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x12, 0x2a, 0x0a,
+	0x11, 0x6b, 0x65, 0x65, 0x70, 0x5f, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x73, 0x75, 0x63, 0x63, 0x65,
+	0x73, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x6b, 0x65, 0x65, 0x70, 0x4c, 0x61,
+	0x73, 0x74, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x22, 0x82, 0x01, 0x0a, 0x0c, 0x50, 0x6c,
+	0x75, 0x67, 0x69, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x37, 0x0a, 0x06, 0x63, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x74, 0x79, 0x70,
+	0x65, 0x73, 0x2e, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e,
+	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x06, 0x63, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x1a, 0x39, 0x0a, 0x0b, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x2d,
+	0x0a, 0x0d, 0x53, 0x65, 0x74, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x1c, 0x0a, 0x03, 0x6a, 0x6f, 0x62, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x74,
+	0x79, 0x70, 0x65, 0x73, 0x2e, 0x4a, 0x6f, 0x62, 0x52, 0x03, 0x6a, 0x6f, 0x62, 0x22, 0x2e, 0x0a,
+	0x0e, 0x53, 0x65, 0x74, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x1c, 0x0a, 0x03, 0x6a, 0x6f, 0x62, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x74,
+	0x79, 0x70, 0x65, 0x73, 0x2e, 0x4a, 0x6f, 0x62, 0x52, 0x03, 0x6a, 0x6f, 0x62, 0x22, 0x2d, 0x0a,
+	0x10, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x19, 0x0a, 0x08, 0x6a, 0x6f, 0x62, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x6a, 0x6f, 0x62, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x31, 0x0a, 0x11,
+	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x1c, 0x0a, 0x03, 0x6a, 0x6f, 0x62, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a,
+	0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x4a, 0x6f, 0x62, 0x52, 0x03, 0x6a, 0x6f, 0x62, 0x22,
+	0x2a, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x19, 0x0a, 0x08, 0x6a, 0x6f, 0x62, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x6a, 0x6f, 0x62, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x2e, 0x0a, 0x0e, 0x47,
+	0x65, 0x74, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1c, 0x0a,
+	0x03, 0x6a, 0x6f, 0x62, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x74, 0x79, 0x70,
+	0x65, 0x73, 0x2e, 0x4a, 0x6f, 0x62, 0x52, 0x03, 0x6a, 0x6f, 0x62, 0x22, 0x9a, 0x07, 0x0a, 0x09,
+	0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x19, 0x0a, 0x08, 0x6a, 0x6f, 0x62,
+	0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6a, 0x6f, 0x62,
+	0x4e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x16,
+	0x0a, 0x06, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06,
+	0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6e, 0x6f, 0x64, 0x65, 0x4e,
+	0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x05, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x74, 0x74,
+	0x65, 0x6d, 0x70, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x61, 0x74, 0x74, 0x65,
+	0x6d, 0x70, 0x74, 0x12, 0x39, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x5f, 0x61,
+	0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x3b,
+	0x0a, 0x0b, 0x66, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x08, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52,
+	0x0a, 0x66, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x74,
+	0x69, 0x6d, 0x65, 0x64, 0x5f, 0x6f, 0x75, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08,
+	0x74, 0x69, 0x6d, 0x65, 0x64, 0x4f, 0x75, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x61, 0x6e, 0x63,
+	0x65, 0x6c, 0x6c, 0x65, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x63, 0x61, 0x6e,
+	0x63, 0x65, 0x6c, 0x6c, 0x65, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x61, 0x6e, 0x63, 0x65, 0x6c,
+	0x6c, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x61,
+	0x6e, 0x63, 0x65, 0x6c, 0x6c, 0x65, 0x64, 0x42, 0x79, 0x12, 0x1b, 0x0a, 0x09, 0x65, 0x78, 0x69,
+	0x74, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x65, 0x78,
+	0x69, 0x74, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x64, 0x6f, 0x75, 0x74,
+	0x18, 0x0d, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x73, 0x74, 0x64, 0x6f, 0x75, 0x74, 0x12, 0x16,
+	0x0a, 0x06, 0x73, 0x74, 0x64, 0x65, 0x72, 0x72, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06,
+	0x73, 0x74, 0x64, 0x65, 0x72, 0x72, 0x12, 0x29, 0x0a, 0x10, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72,
+	0x65, 0x5f, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0f, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72,
+	0x79, 0x12, 0x37, 0x0a, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x10, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x45, 0x78, 0x65, 0x63, 0x75,
+	0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x52, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x6b,
+	0x69, 0x70, 0x70, 0x65, 0x64, 0x18, 0x11, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x6b, 0x69,
+	0x70, 0x70, 0x65, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x6b, 0x69, 0x70, 0x5f, 0x72, 0x65, 0x61,
+	0x73, 0x6f, 0x6e, 0x18, 0x12, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x6b, 0x69, 0x70, 0x52,
+	0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x21, 0x0a, 0x0c, 0x74, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72,
+	0x65, 0x64, 0x5f, 0x62, 0x79, 0x18, 0x13, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x74, 0x72, 0x69,
+	0x67, 0x67, 0x65, 0x72, 0x65, 0x64, 0x42, 0x79, 0x12, 0x21, 0x0a, 0x0c, 0x74, 0x72, 0x69, 0x67,
+	0x67, 0x65, 0x72, 0x5f, 0x65, 0x64, 0x67, 0x65, 0x18, 0x14, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
+	0x74, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x45, 0x64, 0x67, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x77,
+	0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x5f, 0x72, 0x75, 0x6e, 0x18, 0x15, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0b, 0x77, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x52, 0x75, 0x6e, 0x12, 0x4a,
+	0x0a, 0x0e, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x73,
+	0x18, 0x16, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x45,
+	0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x50, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x4f,
+	0x75, 0x74, 0x70, 0x75, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0d, 0x70, 0x61, 0x72,
+	0x65, 0x6e, 0x74, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x73, 0x1a, 0x3a, 0x0a, 0x0c, 0x52, 0x65,
+	0x73, 0x75, 0x6c, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65,
+	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x40, 0x0a, 0x12, 0x50, 0x61, 0x72, 0x65, 0x6e, 0x74,
+	0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03,
+	0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14,
+	0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x46, 0x0a, 0x14, 0x45, 0x78, 0x65, 0x63,
+	0x75, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x6f, 0x6e, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x2e, 0x0a, 0x09, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x45, 0x78, 0x65, 0x63,
+	0x75, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x09, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e,
+	0x22, 0x45, 0x0a, 0x15, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x6f, 0x6e,
+	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x66, 0x72, 0x6f,
+	0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x12, 0x18, 0x0a,
+	0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07,
+	0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x22, 0x40, 0x0a, 0x0d, 0x52, 0x75, 0x6e, 0x4a, 0x6f,
+	0x62, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x6a, 0x6f, 0x62, 0x5f,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6a, 0x6f, 0x62, 0x4e,
+	0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x05, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x22, 0x2e, 0x0a, 0x0e, 0x52, 0x75, 0x6e,
+	0x4a, 0x6f, 0x62, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1c, 0x0a, 0x03, 0x6a,
+	0x6f, 0x62, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73,
+	0x2e, 0x4a, 0x6f, 0x62, 0x52, 0x03, 0x6a, 0x6f, 0x62, 0x22, 0x2d, 0x0a, 0x10, 0x54, 0x6f, 0x67,
+	0x67, 0x6c, 0x65, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a,
+	0x08, 0x6a, 0x6f, 0x62, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x6a, 0x6f, 0x62, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x31, 0x0a, 0x11, 0x54, 0x6f, 0x67, 0x67,
+	0x6c, 0x65, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1c, 0x0a,
+	0x03, 0x6a, 0x6f, 0x62, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x74, 0x79, 0x70,
+	0x65, 0x73, 0x2e, 0x4a, 0x6f, 0x62, 0x52, 0x03, 0x6a, 0x6f, 0x62, 0x22, 0x9d, 0x01, 0x0a, 0x0a,
+	0x52, 0x61, 0x66, 0x74, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x6f,
+	0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x12, 0x18,
+	0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x6c, 0x65, 0x61, 0x64,
+	0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x6c, 0x65, 0x61, 0x64, 0x65, 0x72,
+	0x12, 0x14, 0x0a, 0x05, 0x76, 0x6f, 0x74, 0x65, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x05, 0x76, 0x6f, 0x74, 0x65, 0x72, 0x12, 0x23, 0x0a, 0x0d, 0x72, 0x61, 0x66, 0x74, 0x5f, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x72,
+	0x61, 0x66, 0x74, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x22, 0x61, 0x0a, 0x1c, 0x52,
+	0x61, 0x66, 0x74, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2b, 0x0a, 0x07, 0x73,
+	0x65, 0x72, 0x76, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x74,
+	0x79, 0x70, 0x65, 0x73, 0x2e, 0x52, 0x61, 0x66, 0x74, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x52,
+	0x07, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65,
+	0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x22, 0x2b,
+	0x0a, 0x19, 0x52, 0x61, 0x66, 0x74, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x50, 0x65, 0x65, 0x72,
+	0x42, 0x79, 0x49, 0x44, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x40, 0x0a, 0x0e, 0x41,
+	0x67, 0x65, 0x6e, 0x74, 0x52, 0x75, 0x6e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x12, 0x2e, 0x0a,
+	0x09, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x10, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x09, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x40, 0x0a,
+	0x10, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x52, 0x75, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x12, 0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x66, 0x72, 0x6f, 0x6d, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x22,
+	0x4f, 0x0a, 0x1b, 0x47, 0x65, 0x74, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x45, 0x78, 0x65, 0x63,
+	0x75, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x30,
+	0x0a, 0x0a, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x10, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x45, 0x78, 0x65, 0x63, 0x75,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x22, 0x71, 0x0a, 0x0c, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x74, 0x72, 0x79,
+	0x12, 0x2e, 0x0a, 0x09, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x45, 0x78, 0x65, 0x63,
+	0x75, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x09, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x31, 0x0a, 0x06, 0x72, 0x75, 0x6e, 0x5f, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x05, 0x72, 0x75,
+	0x6e, 0x41, 0x74, 0x22, 0x59, 0x0a, 0x19, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x65, 0x6e,
+	0x64, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x74, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x19, 0x0a, 0x08, 0x6a, 0x6f, 0x62, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x6a, 0x6f, 0x62, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x65,
+	0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0b, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x22, 0x34,
+	0x0a, 0x08, 0x43, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14,
+	0x0a, 0x05, 0x64, 0x61, 0x74, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x64,
+	0x61, 0x74, 0x65, 0x73, 0x22, 0x41, 0x0a, 0x12, 0x53, 0x65, 0x74, 0x43, 0x61, 0x6c, 0x65, 0x6e,
+	0x64, 0x61, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2b, 0x0a, 0x08, 0x63, 0x61,
+	0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x74,
+	0x79, 0x70, 0x65, 0x73, 0x2e, 0x43, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x52, 0x08, 0x63,
+	0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x22, 0x42, 0x0a, 0x13, 0x53, 0x65, 0x74, 0x43, 0x61,
+	0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2b,
+	0x0a, 0x08, 0x63, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x0f, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x43, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61,
+	0x72, 0x52, 0x08, 0x63, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x22, 0x2b, 0x0a, 0x15, 0x44,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x45, 0x0a, 0x16, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x43, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x2b, 0x0a, 0x08, 0x63, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x43, 0x61, 0x6c,
+	0x65, 0x6e, 0x64, 0x61, 0x72, 0x52, 0x08, 0x63, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x22,
+	0xf1, 0x01, 0x0a, 0x0b, 0x55, 0x70, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x75, 0x6e, 0x12,
+	0x14, 0x0a, 0x05, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05,
+	0x67, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12,
+	0x3b, 0x0a, 0x0b, 0x66, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x52, 0x0a, 0x66, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x65, 0x64, 0x41, 0x74, 0x12, 0x39, 0x0a, 0x07,
+	0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e,
+	0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x55, 0x70, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x75,
+	0x6e, 0x2e, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07,
+	0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x73, 0x1a, 0x3a, 0x0a, 0x0c, 0x4f, 0x75, 0x74, 0x70, 0x75,
+	0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a,
+	0x02, 0x38, 0x01, 0x22, 0xb1, 0x02, 0x0a, 0x09, 0x4a, 0x6f, 0x69, 0x6e, 0x53, 0x74, 0x61, 0x74,
+	0x65, 0x12, 0x19, 0x0a, 0x08, 0x6a, 0x6f, 0x62, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x6a, 0x6f, 0x62, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x37, 0x0a, 0x07,
+	0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e,
+	0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x4a, 0x6f, 0x69, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x2e,
+	0x50, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x70, 0x61,
+	0x72, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x41, 0x0a, 0x0b, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x67, 0x72,
+	0x6f, 0x75, 0x70, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x74, 0x79, 0x70,
+	0x65, 0x73, 0x2e, 0x4a, 0x6f, 0x69, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x2e, 0x4c, 0x61, 0x73,
+	0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0a, 0x6c, 0x61,
+	0x73, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x1a, 0x4e, 0x0a, 0x0c, 0x50, 0x61, 0x72, 0x65,
+	0x6e, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x28, 0x0a, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x74, 0x79, 0x70, 0x65,
+	0x73, 0x2e, 0x55, 0x70, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x75, 0x6e, 0x52, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x3d, 0x0a, 0x0f, 0x4c, 0x61, 0x73, 0x74,
+	0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b,
+	0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x8b, 0x01, 0x0a, 0x14, 0x4a, 0x6f, 0x69, 0x6e,
+	0x50, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x52, 0x75, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x19, 0x0a, 0x08, 0x6a, 0x6f, 0x62, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x6a, 0x6f, 0x62, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x70,
+	0x61, 0x72, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x61, 0x72,
+	0x65, 0x6e, 0x74, 0x12, 0x24, 0x0a, 0x03, 0x72, 0x75, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x12, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x55, 0x70, 0x73, 0x74, 0x72, 0x65, 0x61,
+	0x6d, 0x52, 0x75, 0x6e, 0x52, 0x03, 0x72, 0x75, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x75, 0x6e,
+	0x6e, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x72, 0x75, 0x6e,
+	0x6e, 0x61, 0x62, 0x6c, 0x65, 0x22, 0x59, 0x0a, 0x17, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x45,
+	0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x19, 0x0a, 0x08, 0x6a, 0x6f, 0x62, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x6a, 0x6f, 0x62, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x65,
+	0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x0c, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x73,
+	0x22, 0x56, 0x0a, 0x16, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x6a, 0x6f,
+	0x62, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6a, 0x6f,
+	0x62, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69,
+	0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x65, 0x78, 0x65,
+	0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x22, 0x49, 0x0a, 0x17, 0x53, 0x74, 0x72, 0x65,
+	0x61, 0x6d, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x2e, 0x0a, 0x09, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x45,
+	0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x09, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74,
+	0x69, 0x6f, 0x6e, 0x22, 0x5f, 0x0a, 0x0f, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x52, 0x75, 0x6e, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x03, 0x6a, 0x6f, 0x62, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x4a, 0x6f, 0x62, 0x52,
+	0x03, 0x6a, 0x6f, 0x62, 0x12, 0x2e, 0x0a, 0x09, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e,
+	0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x09, 0x65, 0x78, 0x65, 0x63, 0x75,
+	0x74, 0x69, 0x6f, 0x6e, 0x22, 0x73, 0x0a, 0x14, 0x53, 0x74, 0x6f, 0x70, 0x45, 0x78, 0x65, 0x63,
+	0x75, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08,
+	0x6a, 0x6f, 0x62, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x6a, 0x6f, 0x62, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x65, 0x78, 0x65, 0x63, 0x75,
+	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x65,
+	0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x74,
+	0x6f, 0x70, 0x70, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x73, 0x74, 0x6f, 0x70, 0x70, 0x65, 0x64, 0x42, 0x79, 0x22, 0x2b, 0x0a, 0x15, 0x53, 0x74, 0x6f,
+	0x70, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x22, 0x84, 0x01, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x45, 0x78,
+	0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x6f, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x19, 0x0a, 0x08, 0x6a, 0x6f, 0x62, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x6a, 0x6f, 0x62, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x21, 0x0a, 0x0c,
+	0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0b, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12,
+	0x16, 0x0a, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x22, 0x7a, 0x0a,
+	0x17, 0x47, 0x65, 0x74, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x6f, 0x67,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x16, 0x0a, 0x06,
+	0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x6f, 0x66,
+	0x66, 0x73, 0x65, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x6f, 0x66, 0x66,
+	0x73, 0x65, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x6e, 0x65, 0x78, 0x74, 0x4f,
+	0x66, 0x66, 0x73, 0x65, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x22, 0x5c, 0x0a, 0x1a, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x6f, 0x67, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x6a, 0x6f, 0x62, 0x5f, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6a, 0x6f, 0x62, 0x4e, 0x61,
+	0x6d, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
+	0x69, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0c, 0x65, 0x78, 0x65, 0x63, 0x75,
+	0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x73, 0x32, 0xd0, 0x07, 0x0a, 0x09, 0x53, 0x70, 0x69, 0x64,
+	0x65, 0x72, 0x6a, 0x6f, 0x62, 0x12, 0x35, 0x0a, 0x06, 0x47, 0x65, 0x74, 0x4a, 0x6f, 0x62, 0x12,
+	0x14, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x4a, 0x6f, 0x62, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x47, 0x65,
+	0x74, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4a, 0x0a, 0x0d,
+	0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x6f, 0x6e, 0x65, 0x12, 0x1b, 0x2e,
+	0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x44,
+	0x6f, 0x6e, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x74, 0x79, 0x70,
+	0x65, 0x73, 0x2e, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x6f, 0x6e, 0x65,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x37, 0x0a, 0x05, 0x4c, 0x65, 0x61, 0x76,
+	0x65, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74,
+	0x79, 0x12, 0x35, 0x0a, 0x06, 0x53, 0x65, 0x74, 0x4a, 0x6f, 0x62, 0x12, 0x14, 0x2e, 0x74, 0x79,
+	0x70, 0x65, 0x73, 0x2e, 0x53, 0x65, 0x74, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x15, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x53, 0x65, 0x74, 0x4a, 0x6f, 0x62,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3e, 0x0a, 0x09, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x4a, 0x6f, 0x62, 0x12, 0x17, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18,
+	0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4a, 0x6f, 0x62,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x35, 0x0a, 0x06, 0x52, 0x75, 0x6e, 0x4a,
+	0x6f, 0x62, 0x12, 0x14, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x52, 0x75, 0x6e, 0x4a, 0x6f,
+	0x62, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73,
+	0x2e, 0x52, 0x75, 0x6e, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x3e, 0x0a, 0x09, 0x54, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x4a, 0x6f, 0x62, 0x12, 0x17, 0x2e, 0x74,
+	0x79, 0x70, 0x65, 0x73, 0x2e, 0x54, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x4a, 0x6f, 0x62, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x54, 0x6f,
+	0x67, 0x67, 0x6c, 0x65, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x53, 0x0a, 0x14, 0x52, 0x61, 0x66, 0x74, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a,
+	0x23, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x52, 0x61, 0x66, 0x74, 0x47, 0x65, 0x74, 0x43,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4e, 0x0a, 0x12, 0x52, 0x61, 0x66, 0x74, 0x52, 0x65, 0x6d, 0x6f,
+	0x76, 0x65, 0x50, 0x65, 0x65, 0x72, 0x42, 0x79, 0x49, 0x44, 0x12, 0x20, 0x2e, 0x74, 0x79, 0x70,
+	0x65, 0x73, 0x2e, 0x52, 0x61, 0x66, 0x74, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x50, 0x65, 0x65,
+	0x72, 0x42, 0x79, 0x49, 0x44, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45,
+	0x6d, 0x70, 0x74, 0x79, 0x12, 0x51, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x41, 0x63, 0x74, 0x69, 0x76,
+	0x65, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x16, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d,
+	0x70, 0x74, 0x79, 0x1a, 0x22, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x41,
+	0x63, 0x74, 0x69, 0x76, 0x65, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x38, 0x0a, 0x0c, 0x53, 0x65, 0x74, 0x45, 0x78,
+	0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x10, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e,
+	0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74,
+	0x79, 0x12, 0x52, 0x0a, 0x0f, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x45, 0x78, 0x65, 0x63, 0x75,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1d, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x53, 0x74, 0x72,
+	0x65, 0x61, 0x6d, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x53, 0x74, 0x72, 0x65,
+	0x61, 0x6d, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x30, 0x01, 0x12, 0x44, 0x0a, 0x0b, 0x53, 0x65, 0x74, 0x43, 0x61, 0x6c, 0x65,
+	0x6e, 0x64, 0x61, 0x72, 0x12, 0x19, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x53, 0x65, 0x74,
+	0x43, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x1a, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x53, 0x65, 0x74, 0x43, 0x61, 0x6c, 0x65, 0x6e,
+	0x64, 0x61, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4d, 0x0a, 0x0e, 0x44,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x12, 0x1c, 0x2e,
+	0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x61, 0x6c, 0x65,
+	0x6e, 0x64, 0x61, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x74, 0x79,
+	0x70, 0x65, 0x73, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x61, 0x6c, 0x65, 0x6e, 0x64,
+	0x61, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x32, 0xb4, 0x02, 0x0a, 0x05, 0x41,
+	0x67, 0x65, 0x6e, 0x74, 0x12, 0x3b, 0x0a, 0x08, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x52, 0x75, 0x6e,
+	0x12, 0x16, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x52, 0x75,
+	0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73,
+	0x2e, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x52, 0x75, 0x6e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x30,
+	0x01, 0x12, 0x4a, 0x0a, 0x0d, 0x53, 0x74, 0x6f, 0x70, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x1b, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x53, 0x74, 0x6f, 0x70, 0x45,
+	0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x1c, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x53, 0x74, 0x6f, 0x70, 0x45, 0x78, 0x65, 0x63,
+	0x75, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x50, 0x0a,
+	0x0f, 0x47, 0x65, 0x74, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x6f, 0x67,
+	0x12, 0x1d, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x45, 0x78, 0x65, 0x63,
+	0x75, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x6f, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x1e, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x45, 0x78, 0x65, 0x63, 0x75,
+	0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x6f, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x50, 0x0a, 0x13, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69,
+	0x6f, 0x6e, 0x4c, 0x6f, 0x67, 0x73, 0x12, 0x21, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x44,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x6f,
+	0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74,
+	0x79, 0x42, 0x11, 0x5a, 0x0f, 0x2e, 0x2e, 0x2f, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x2f, 0x74,
+	0x79, 0x70, 0x65, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
-	ErrInvalidLengthSpiderjob        = fmt.Errorf("proto: negative length found during unmarshaling")
-	ErrIntOverflowSpiderjob          = fmt.Errorf("proto: integer overflow")
-	ErrUnexpectedEndOfGroupSpiderjob = fmt.Errorf("proto: unexpected end of group")
+	file_spiderjob_proto_rawDescOnce sync.Once
+	file_spiderjob_proto_rawDescData = file_spiderjob_proto_rawDesc
 )
+
+func file_spiderjob_proto_rawDescGZIP() []byte {
+	file_spiderjob_proto_rawDescOnce.Do(func() {
+		file_spiderjob_proto_rawDescData = protoimpl.X.CompressGZIP(file_spiderjob_proto_rawDescData)
+	})
+	return file_spiderjob_proto_rawDescData
+}
+
+var file_spiderjob_proto_msgTypes = make([]protoimpl.MessageInfo, 55)
+var file_spiderjob_proto_goTypes = []interface{}{
+	(*Job)(nil),                          // 0: types.Job
+	(*TimeWindow)(nil),                   // 1: types.TimeWindow
+	(*MissedRunPolicy)(nil),              // 2: types.MissedRunPolicy
+	(*RetryPolicy)(nil),                  // 3: types.RetryPolicy
+	(*RetentionPolicy)(nil),              // 4: types.RetentionPolicy
+	(*PluginConfig)(nil),                 // 5: types.PluginConfig
+	(*SetJobRequest)(nil),                // 6: types.SetJobRequest
+	(*SetJobResponse)(nil),               // 7: types.SetJobResponse
+	(*DeleteJobRequest)(nil),             // 8: types.DeleteJobRequest
+	(*DeleteJobResponse)(nil),            // 9: types.DeleteJobResponse
+	(*GetJobRequest)(nil),                // 10: types.GetJobRequest
+	(*GetJobResponse)(nil),               // 11: types.GetJobResponse
+	(*Execution)(nil),                    // 12: types.Execution
+	(*ExecutionDoneRequest)(nil),         // 13: types.ExecutionDoneRequest
+	(*ExecutionDoneResponse)(nil),        // 14: types.ExecutionDoneResponse
+	(*RunJobRequest)(nil),                // 15: types.RunJobRequest
+	(*RunJobResponse)(nil),               // 16: types.RunJobResponse
+	(*ToggleJobRequest)(nil),             // 17: types.ToggleJobRequest
+	(*ToggleJobResponse)(nil),            // 18: types.ToggleJobResponse
+	(*RaftServer)(nil),                   // 19: types.RaftServer
+	(*RaftGetConfigurationResponse)(nil), // 20: types.RaftGetConfigurationResponse
+	(*RaftRemovePeerByIDRequest)(nil),    // 21: types.RaftRemovePeerByIDRequest
+	(*AgentRunStream)(nil),               // 22: types.AgentRunStream
+	(*AgentRunResponse)(nil),             // 23: types.AgentRunResponse
+	(*GetActiveExecutionsResponse)(nil),  // 24: types.GetActiveExecutionsResponse
+	(*PendingRetry)(nil),                 // 25: types.PendingRetry
+	(*DeletePendingRetryRequest)(nil),    // 26: types.DeletePendingRetryRequest
+	(*Calendar)(nil),                     // 27: types.Calendar
+	(*SetCalendarRequest)(nil),           // 28: types.SetCalendarRequest
+	(*SetCalendarResponse)(nil),          // 29: types.SetCalendarResponse
+	(*DeleteCalendarRequest)(nil),        // 30: types.DeleteCalendarRequest
+	(*DeleteCalendarResponse)(nil),       // 31: types.DeleteCalendarResponse
+	(*UpstreamRun)(nil),                  // 32: types.UpstreamRun
+	(*JoinState)(nil),                    // 33: types.JoinState
+	(*JoinParentRunRequest)(nil),         // 34: types.JoinParentRunRequest
+	(*DeleteExecutionsRequest)(nil),      // 35: types.DeleteExecutionsRequest
+	(*StreamExecutionRequest)(nil),       // 36: types.StreamExecutionRequest
+	(*StreamExecutionResponse)(nil),      // 37: types.StreamExecutionResponse
+	(*AgentRunRequest)(nil),              // 38: types.AgentRunRequest
+	(*StopExecutionRequest)(nil),         // 39: types.StopExecutionRequest
+	(*StopExecutionResponse)(nil),        // 40: types.StopExecutionResponse
+	(*GetExecutionLogRequest)(nil),       // 41: types.GetExecutionLogRequest
+	(*GetExecutionLogResponse)(nil),      // 42: types.GetExecutionLogResponse
+	(*DeleteExecutionLogsRequest)(nil),   // 43: types.DeleteExecutionLogsRequest
+	nil,                                  // 44: types.Job.TagsEntry
+	nil,                                  // 45: types.Job.ExecutorConfigEntry
+	nil,                                  // 46: types.Job.MetadataEntry
+	(*Job_NullableTime)(nil),             // 47: types.Job.NullableTime
+	nil,                                  // 48: types.Job.ProcessorsEntry
+	nil,                                  // 49: types.PluginConfig.ConfigEntry
+	nil,                                  // 50: types.Execution.ResultsEntry
+	nil,                                  // 51: types.Execution.ParentOutputsEntry
+	nil,                                  // 52: types.UpstreamRun.OutputsEntry
+	nil,                                  // 53: types.JoinState.ParentsEntry
+	nil,                                  // 54: types.JoinState.LastGroupsEntry
+	(*timestamppb.Timestamp)(nil),        // 55: google.protobuf.Timestamp
+	(*emptypb.Empty)(nil),                // 56: google.protobuf.Empty
+}
+var file_spiderjob_proto_depIdxs = []int32{
+	44, // 0: types.Job.tags:type_name -> types.Job.TagsEntry
+	45, // 1: types.Job.executor_config:type_name -> types.Job.ExecutorConfigEntry
+	46, // 2: types.Job.metadata:type_name -> types.Job.MetadataEntry
+	47, // 3: types.Job.last_success:type_name -> types.Job.NullableTime
+	47, // 4: types.Job.last_error:type_name -> types.Job.NullableTime
+	55, // 5: types.Job.next:type_name -> google.protobuf.Timestamp
+	48, // 6: types.Job.processors:type_name -> types.Job.ProcessorsEntry
+	4,  // 7: types.Job.retention:type_name -> types.RetentionPolicy
+	3,  // 8: types.Job.retry_policy:type_name -> types.RetryPolicy
+	2,  // 9: types.Job.missed_run_policy:type_name -> types.MissedRunPolicy
+	1,  // 10: types.Job.allowed_windows:type_name -> types.TimeWindow
+	49, // 11: types.PluginConfig.config:type_name -> types.PluginConfig.ConfigEntry
+	0,  // 12: types.SetJobRequest.job:type_name -> types.Job
+	0,  // 13: types.SetJobResponse.job:type_name -> types.Job
+	0,  // 14: types.DeleteJobResponse.job:type_name -> types.Job
+	0,  // 15: types.GetJobResponse.job:type_name -> types.Job
+	55, // 16: types.Execution.started_at:type_name -> google.protobuf.Timestamp
+	55, // 17: types.Execution.finished_at:type_name -> google.protobuf.Timestamp
+	50, // 18: types.Execution.results:type_name -> types.Execution.ResultsEntry
+	51, // 19: types.Execution.parent_outputs:type_name -> types.Execution.ParentOutputsEntry
+	12, // 20: types.ExecutionDoneRequest.execution:type_name -> types.Execution
+	0,  // 21: types.RunJobResponse.job:type_name -> types.Job
+	0,  // 22: types.ToggleJobResponse.job:type_name -> types.Job
+	19, // 23: types.RaftGetConfigurationResponse.servers:type_name -> types.RaftServer
+	12, // 24: types.AgentRunStream.execution:type_name -> types.Execution
+	12, // 25: types.GetActiveExecutionsResponse.executions:type_name -> types.Execution
+	12, // 26: types.PendingRetry.execution:type_name -> types.Execution
+	55, // 27: types.PendingRetry.run_at:type_name -> google.protobuf.Timestamp
+	27, // 28: types.SetCalendarRequest.calendar:type_name -> types.Calendar
+	27, // 29: types.SetCalendarResponse.calendar:type_name -> types.Calendar
+	27, // 30: types.DeleteCalendarResponse.calendar:type_name -> types.Calendar
+	55, // 31: types.UpstreamRun.finished_at:type_name -> google.protobuf.Timestamp
+	52, // 32: types.UpstreamRun.outputs:type_name -> types.UpstreamRun.OutputsEntry
+	53, // 33: types.JoinState.parents:type_name -> types.JoinState.ParentsEntry
+	54, // 34: types.JoinState.last_groups:type_name -> types.JoinState.LastGroupsEntry
+	32, // 35: types.JoinParentRunRequest.run:type_name -> types.UpstreamRun
+	12, // 36: types.StreamExecutionResponse.execution:type_name -> types.Execution
+	0,  // 37: types.AgentRunRequest.job:type_name -> types.Job
+	12, // 38: types.AgentRunRequest.execution:type_name -> types.Execution
+	55, // 39: types.Job.NullableTime.time:type_name -> google.protobuf.Timestamp
+	5,  // 40: types.Job.ProcessorsEntry.value:type_name -> types.PluginConfig
+	32, // 41: types.JoinState.ParentsEntry.value:type_name -> types.UpstreamRun
+	10, // 42: types.Spiderjob.GetJob:input_type -> types.GetJobRequest
+	13, // 43: types.Spiderjob.ExecutionDone:input_type -> types.ExecutionDoneRequest
+	56, // 44: types.Spiderjob.Leave:input_type -> google.protobuf.Empty
+	6,  // 45: types.Spiderjob.SetJob:input_type -> types.SetJobRequest
+	8,  // 46: types.Spiderjob.DeleteJob:input_type -> types.DeleteJobRequest
+	15, // 47: types.Spiderjob.RunJob:input_type -> types.RunJobRequest
+	17, // 48: types.Spiderjob.ToggleJob:input_type -> types.ToggleJobRequest
+	56, // 49: types.Spiderjob.RaftGetConfiguration:input_type -> google.protobuf.Empty
+	21, // 50: types.Spiderjob.RaftRemovePeerByID:input_type -> types.RaftRemovePeerByIDRequest
+	56, // 51: types.Spiderjob.GetActiveExecutions:input_type -> google.protobuf.Empty
+	12, // 52: types.Spiderjob.SetExecution:input_type -> types.Execution
+	36, // 53: types.Spiderjob.StreamExecution:input_type -> types.StreamExecutionRequest
+	28, // 54: types.Spiderjob.SetCalendar:input_type -> types.SetCalendarRequest
+	30, // 55: types.Spiderjob.DeleteCalendar:input_type -> types.DeleteCalendarRequest
+	38, // 56: types.Agent.AgentRun:input_type -> types.AgentRunRequest
+	39, // 57: types.Agent.StopExecution:input_type -> types.StopExecutionRequest
+	41, // 58: types.Agent.GetExecutionLog:input_type -> types.GetExecutionLogRequest
+	43, // 59: types.Agent.DeleteExecutionLogs:input_type -> types.DeleteExecutionLogsRequest
+	11, // 60: types.Spiderjob.GetJob:output_type -> types.GetJobResponse
+	14, // 61: types.Spiderjob.ExecutionDone:output_type -> types.ExecutionDoneResponse
+	56, // 62: types.Spiderjob.Leave:output_type -> google.protobuf.Empty
+	7,  // 63: types.Spiderjob.SetJob:output_type -> types.SetJobResponse
+	9,  // 64: types.Spiderjob.DeleteJob:output_type -> types.DeleteJobResponse
+	16, // 65: types.Spiderjob.RunJob:output_type -> types.RunJobResponse
+	18, // 66: types.Spiderjob.ToggleJob:output_type -> types.ToggleJobResponse
+	20, // 67: types.Spiderjob.RaftGetConfiguration:output_type -> types.RaftGetConfigurationResponse
+	56, // 68: types.Spiderjob.RaftRemovePeerByID:output_type -> google.protobuf.Empty
+	24, // 69: types.Spiderjob.GetActiveExecutions:output_type -> types.GetActiveExecutionsResponse
+	56, // 70: types.Spiderjob.SetExecution:output_type -> google.protobuf.Empty
+	37, // 71: types.Spiderjob.StreamExecution:output_type -> types.StreamExecutionResponse
+	29, // 72: types.Spiderjob.SetCalendar:output_type -> types.SetCalendarResponse
+	31, // 73: types.Spiderjob.DeleteCalendar:output_type -> types.DeleteCalendarResponse
+	22, // 74: types.Agent.AgentRun:output_type -> types.AgentRunStream
+	40, // 75: types.Agent.StopExecution:output_type -> types.StopExecutionResponse
+	42, // 76: types.Agent.GetExecutionLog:output_type -> types.GetExecutionLogResponse
+	56, // 77: types.Agent.DeleteExecutionLogs:output_type -> google.protobuf.Empty
+	60, // [60:78] is the sub-list for method output_type
+	42, // [42:60] is the sub-list for method input_type
+	42, // [42:42] is the sub-list for extension type_name
+	42, // [42:42] is the sub-list for extension extendee
+	0,  // [0:42] is the sub-list for field type_name
+}
+
+func init() { file_spiderjob_proto_init() }
+func file_spiderjob_proto_init() {
+	if File_spiderjob_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_spiderjob_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Job); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_spiderjob_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TimeWindow); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_spiderjob_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MissedRunPolicy); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_spiderjob_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RetryPolicy); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_spiderjob_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RetentionPolicy); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_spiderjob_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PluginConfig); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_spiderjob_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetJobRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_spiderjob_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetJobResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_spiderjob_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteJobRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_spiderjob_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteJobResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_spiderjob_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetJobRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_spiderjob_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetJobResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_spiderjob_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Execution); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_spiderjob_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExecutionDoneRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_spiderjob_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExecutionDoneResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_spiderjob_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RunJobRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_spiderjob_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RunJobResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_spiderjob_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ToggleJobRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_spiderjob_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ToggleJobResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_spiderjob_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RaftServer); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_spiderjob_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RaftGetConfigurationResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_spiderjob_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RaftRemovePeerByIDRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_spiderjob_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AgentRunStream); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_spiderjob_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AgentRunResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_spiderjob_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetActiveExecutionsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_spiderjob_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PendingRetry); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_spiderjob_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeletePendingRetryRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_spiderjob_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Calendar); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_spiderjob_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetCalendarRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_spiderjob_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetCalendarResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_spiderjob_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteCalendarRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_spiderjob_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteCalendarResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_spiderjob_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpstreamRun); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_spiderjob_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*JoinState); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_spiderjob_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*JoinParentRunRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_spiderjob_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteExecutionsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_spiderjob_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StreamExecutionRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_spiderjob_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StreamExecutionResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_spiderjob_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AgentRunRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_spiderjob_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StopExecutionRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_spiderjob_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StopExecutionResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_spiderjob_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetExecutionLogRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_spiderjob_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetExecutionLogResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_spiderjob_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteExecutionLogsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_spiderjob_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Job_NullableTime); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_spiderjob_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   55,
+			NumExtensions: 0,
+			NumServices:   2,
+		},
+		GoTypes:           file_spiderjob_proto_goTypes,
+		DependencyIndexes: file_spiderjob_proto_depIdxs,
+		MessageInfos:      file_spiderjob_proto_msgTypes,
+	}.Build()
+	File_spiderjob_proto = out.File
+	file_spiderjob_proto_rawDesc = nil
+	file_spiderjob_proto_goTypes = nil
+	file_spiderjob_proto_depIdxs = nil
+}
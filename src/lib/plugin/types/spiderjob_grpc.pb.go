@@ -7,7 +7,7 @@ import (
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
-	// protobuf "google/protobuf"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
 )
 
 // This is a compile-time assertion to ensure that this generated file
@@ -21,15 +21,18 @@ const _ = grpc.SupportPackageIsVersion7
 type SpiderjobClient interface {
 	GetJob(ctx context.Context, in *GetJobRequest, opts ...grpc.CallOption) (*GetJobResponse, error)
 	ExecutionDone(ctx context.Context, in *ExecutionDoneRequest, opts ...grpc.CallOption) (*ExecutionDoneResponse, error)
-	Leave(ctx context.Context, in *protobuf.Empty, opts ...grpc.CallOption) (*protobuf.Empty, error)
+	Leave(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	SetJob(ctx context.Context, in *SetJobRequest, opts ...grpc.CallOption) (*SetJobResponse, error)
 	DeleteJob(ctx context.Context, in *DeleteJobRequest, opts ...grpc.CallOption) (*DeleteJobResponse, error)
 	RunJob(ctx context.Context, in *RunJobRequest, opts ...grpc.CallOption) (*RunJobResponse, error)
 	ToggleJob(ctx context.Context, in *ToggleJobRequest, opts ...grpc.CallOption) (*ToggleJobResponse, error)
-	RaftGetConfiguration(ctx context.Context, in *protobuf.Empty, opts ...grpc.CallOption) (*RaftGetConfigurationResponse, error)
-	RaftRemovePeerByID(ctx context.Context, in *RaftRemovePeerByIDRequest, opts ...grpc.CallOption) (*protobuf.Empty, error)
-	GetActiveExecutions(ctx context.Context, in *protobuf.Empty, opts ...grpc.CallOption) (*GetActiveExecutionsResponse, error)
-	SetExecution(ctx context.Context, in *Execution, opts ...grpc.CallOption) (*protobuf.Empty, error)
+	RaftGetConfiguration(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*RaftGetConfigurationResponse, error)
+	RaftRemovePeerByID(ctx context.Context, in *RaftRemovePeerByIDRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	GetActiveExecutions(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*GetActiveExecutionsResponse, error)
+	SetExecution(ctx context.Context, in *Execution, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	StreamExecution(ctx context.Context, in *StreamExecutionRequest, opts ...grpc.CallOption) (Spiderjob_StreamExecutionClient, error)
+	SetCalendar(ctx context.Context, in *SetCalendarRequest, opts ...grpc.CallOption) (*SetCalendarResponse, error)
+	DeleteCalendar(ctx context.Context, in *DeleteCalendarRequest, opts ...grpc.CallOption) (*DeleteCalendarResponse, error)
 }
 
 type spiderjobClient struct {
@@ -58,8 +61,8 @@ func (c *spiderjobClient) ExecutionDone(ctx context.Context, in *ExecutionDoneRe
 	return out, nil
 }
 
-func (c *spiderjobClient) Leave(ctx context.Context, in *protobuf.Empty, opts ...grpc.CallOption) (*protobuf.Empty, error) {
-	out := new(protobuf.Empty)
+func (c *spiderjobClient) Leave(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
 	err := c.cc.Invoke(ctx, "/types.Spiderjob/Leave", in, out, opts...)
 	if err != nil {
 		return nil, err
@@ -103,7 +106,7 @@ func (c *spiderjobClient) ToggleJob(ctx context.Context, in *ToggleJobRequest, o
 	return out, nil
 }
 
-func (c *spiderjobClient) RaftGetConfiguration(ctx context.Context, in *protobuf.Empty, opts ...grpc.CallOption) (*RaftGetConfigurationResponse, error) {
+func (c *spiderjobClient) RaftGetConfiguration(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*RaftGetConfigurationResponse, error) {
 	out := new(RaftGetConfigurationResponse)
 	err := c.cc.Invoke(ctx, "/types.Spiderjob/RaftGetConfiguration", in, out, opts...)
 	if err != nil {
@@ -112,8 +115,8 @@ func (c *spiderjobClient) RaftGetConfiguration(ctx context.Context, in *protobuf
 	return out, nil
 }
 
-func (c *spiderjobClient) RaftRemovePeerByID(ctx context.Context, in *RaftRemovePeerByIDRequest, opts ...grpc.CallOption) (*protobuf.Empty, error) {
-	out := new(protobuf.Empty)
+func (c *spiderjobClient) RaftRemovePeerByID(ctx context.Context, in *RaftRemovePeerByIDRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
 	err := c.cc.Invoke(ctx, "/types.Spiderjob/RaftRemovePeerByID", in, out, opts...)
 	if err != nil {
 		return nil, err
@@ -121,7 +124,7 @@ func (c *spiderjobClient) RaftRemovePeerByID(ctx context.Context, in *RaftRemove
 	return out, nil
 }
 
-func (c *spiderjobClient) GetActiveExecutions(ctx context.Context, in *protobuf.Empty, opts ...grpc.CallOption) (*GetActiveExecutionsResponse, error) {
+func (c *spiderjobClient) GetActiveExecutions(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*GetActiveExecutionsResponse, error) {
 	out := new(GetActiveExecutionsResponse)
 	err := c.cc.Invoke(ctx, "/types.Spiderjob/GetActiveExecutions", in, out, opts...)
 	if err != nil {
@@ -130,8 +133,8 @@ func (c *spiderjobClient) GetActiveExecutions(ctx context.Context, in *protobuf.
 	return out, nil
 }
 
-func (c *spiderjobClient) SetExecution(ctx context.Context, in *Execution, opts ...grpc.CallOption) (*protobuf.Empty, error) {
-	out := new(protobuf.Empty)
+func (c *spiderjobClient) SetExecution(ctx context.Context, in *Execution, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
 	err := c.cc.Invoke(ctx, "/types.Spiderjob/SetExecution", in, out, opts...)
 	if err != nil {
 		return nil, err
@@ -139,21 +142,74 @@ func (c *spiderjobClient) SetExecution(ctx context.Context, in *Execution, opts
 	return out, nil
 }
 
+func (c *spiderjobClient) StreamExecution(ctx context.Context, in *StreamExecutionRequest, opts ...grpc.CallOption) (Spiderjob_StreamExecutionClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Spiderjob_ServiceDesc.Streams[0], "/types.Spiderjob/StreamExecution", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &spiderjobStreamExecutionClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Spiderjob_StreamExecutionClient interface {
+	Recv() (*StreamExecutionResponse, error)
+	grpc.ClientStream
+}
+
+type spiderjobStreamExecutionClient struct {
+	grpc.ClientStream
+}
+
+func (x *spiderjobStreamExecutionClient) Recv() (*StreamExecutionResponse, error) {
+	m := new(StreamExecutionResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *spiderjobClient) SetCalendar(ctx context.Context, in *SetCalendarRequest, opts ...grpc.CallOption) (*SetCalendarResponse, error) {
+	out := new(SetCalendarResponse)
+	err := c.cc.Invoke(ctx, "/types.Spiderjob/SetCalendar", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *spiderjobClient) DeleteCalendar(ctx context.Context, in *DeleteCalendarRequest, opts ...grpc.CallOption) (*DeleteCalendarResponse, error) {
+	out := new(DeleteCalendarResponse)
+	err := c.cc.Invoke(ctx, "/types.Spiderjob/DeleteCalendar", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // SpiderjobServer is the server API for Spiderjob service.
 // All implementations must embed UnimplementedSpiderjobServer
 // for forward compatibility
 type SpiderjobServer interface {
 	GetJob(context.Context, *GetJobRequest) (*GetJobResponse, error)
 	ExecutionDone(context.Context, *ExecutionDoneRequest) (*ExecutionDoneResponse, error)
-	Leave(context.Context, *protobuf.Empty) (*protobuf.Empty, error)
+	Leave(context.Context, *emptypb.Empty) (*emptypb.Empty, error)
 	SetJob(context.Context, *SetJobRequest) (*SetJobResponse, error)
 	DeleteJob(context.Context, *DeleteJobRequest) (*DeleteJobResponse, error)
 	RunJob(context.Context, *RunJobRequest) (*RunJobResponse, error)
 	ToggleJob(context.Context, *ToggleJobRequest) (*ToggleJobResponse, error)
-	RaftGetConfiguration(context.Context, *protobuf.Empty) (*RaftGetConfigurationResponse, error)
-	RaftRemovePeerByID(context.Context, *RaftRemovePeerByIDRequest) (*protobuf.Empty, error)
-	GetActiveExecutions(context.Context, *protobuf.Empty) (*GetActiveExecutionsResponse, error)
-	SetExecution(context.Context, *Execution) (*protobuf.Empty, error)
+	RaftGetConfiguration(context.Context, *emptypb.Empty) (*RaftGetConfigurationResponse, error)
+	RaftRemovePeerByID(context.Context, *RaftRemovePeerByIDRequest) (*emptypb.Empty, error)
+	GetActiveExecutions(context.Context, *emptypb.Empty) (*GetActiveExecutionsResponse, error)
+	SetExecution(context.Context, *Execution) (*emptypb.Empty, error)
+	StreamExecution(*StreamExecutionRequest, Spiderjob_StreamExecutionServer) error
+	SetCalendar(context.Context, *SetCalendarRequest) (*SetCalendarResponse, error)
+	DeleteCalendar(context.Context, *DeleteCalendarRequest) (*DeleteCalendarResponse, error)
 	mustEmbedUnimplementedSpiderjobServer()
 }
 
@@ -167,7 +223,7 @@ func (UnimplementedSpiderjobServer) GetJob(context.Context, *GetJobRequest) (*Ge
 func (UnimplementedSpiderjobServer) ExecutionDone(context.Context, *ExecutionDoneRequest) (*ExecutionDoneResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ExecutionDone not implemented")
 }
-func (UnimplementedSpiderjobServer) Leave(context.Context, *protobuf.Empty) (*protobuf.Empty, error) {
+func (UnimplementedSpiderjobServer) Leave(context.Context, *emptypb.Empty) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Leave not implemented")
 }
 func (UnimplementedSpiderjobServer) SetJob(context.Context, *SetJobRequest) (*SetJobResponse, error) {
@@ -182,18 +238,27 @@ func (UnimplementedSpiderjobServer) RunJob(context.Context, *RunJobRequest) (*Ru
 func (UnimplementedSpiderjobServer) ToggleJob(context.Context, *ToggleJobRequest) (*ToggleJobResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ToggleJob not implemented")
 }
-func (UnimplementedSpiderjobServer) RaftGetConfiguration(context.Context, *protobuf.Empty) (*RaftGetConfigurationResponse, error) {
+func (UnimplementedSpiderjobServer) RaftGetConfiguration(context.Context, *emptypb.Empty) (*RaftGetConfigurationResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RaftGetConfiguration not implemented")
 }
-func (UnimplementedSpiderjobServer) RaftRemovePeerByID(context.Context, *RaftRemovePeerByIDRequest) (*protobuf.Empty, error) {
+func (UnimplementedSpiderjobServer) RaftRemovePeerByID(context.Context, *RaftRemovePeerByIDRequest) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RaftRemovePeerByID not implemented")
 }
-func (UnimplementedSpiderjobServer) GetActiveExecutions(context.Context, *protobuf.Empty) (*GetActiveExecutionsResponse, error) {
+func (UnimplementedSpiderjobServer) GetActiveExecutions(context.Context, *emptypb.Empty) (*GetActiveExecutionsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetActiveExecutions not implemented")
 }
-func (UnimplementedSpiderjobServer) SetExecution(context.Context, *Execution) (*protobuf.Empty, error) {
+func (UnimplementedSpiderjobServer) SetExecution(context.Context, *Execution) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SetExecution not implemented")
 }
+func (UnimplementedSpiderjobServer) StreamExecution(*StreamExecutionRequest, Spiderjob_StreamExecutionServer) error {
+	return status.Errorf(codes.Unimplemented, "method StreamExecution not implemented")
+}
+func (UnimplementedSpiderjobServer) SetCalendar(context.Context, *SetCalendarRequest) (*SetCalendarResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetCalendar not implemented")
+}
+func (UnimplementedSpiderjobServer) DeleteCalendar(context.Context, *DeleteCalendarRequest) (*DeleteCalendarResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteCalendar not implemented")
+}
 func (UnimplementedSpiderjobServer) mustEmbedUnimplementedSpiderjobServer() {}
 
 // UnsafeSpiderjobServer may be embedded to opt out of forward compatibility for this service.
@@ -244,7 +309,7 @@ func _Spiderjob_ExecutionDone_Handler(srv interface{}, ctx context.Context, dec
 }
 
 func _Spiderjob_Leave_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(protobuf.Empty)
+	in := new(emptypb.Empty)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
@@ -256,7 +321,7 @@ func _Spiderjob_Leave_Handler(srv interface{}, ctx context.Context, dec func(int
 		FullMethod: "/types.Spiderjob/Leave",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SpiderjobServer).Leave(ctx, req.(*protobuf.Empty))
+		return srv.(SpiderjobServer).Leave(ctx, req.(*emptypb.Empty))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -334,7 +399,7 @@ func _Spiderjob_ToggleJob_Handler(srv interface{}, ctx context.Context, dec func
 }
 
 func _Spiderjob_RaftGetConfiguration_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(protobuf.Empty)
+	in := new(emptypb.Empty)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
@@ -346,7 +411,7 @@ func _Spiderjob_RaftGetConfiguration_Handler(srv interface{}, ctx context.Contex
 		FullMethod: "/types.Spiderjob/RaftGetConfiguration",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SpiderjobServer).RaftGetConfiguration(ctx, req.(*protobuf.Empty))
+		return srv.(SpiderjobServer).RaftGetConfiguration(ctx, req.(*emptypb.Empty))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -370,7 +435,7 @@ func _Spiderjob_RaftRemovePeerByID_Handler(srv interface{}, ctx context.Context,
 }
 
 func _Spiderjob_GetActiveExecutions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(protobuf.Empty)
+	in := new(emptypb.Empty)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
@@ -382,7 +447,7 @@ func _Spiderjob_GetActiveExecutions_Handler(srv interface{}, ctx context.Context
 		FullMethod: "/types.Spiderjob/GetActiveExecutions",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SpiderjobServer).GetActiveExecutions(ctx, req.(*protobuf.Empty))
+		return srv.(SpiderjobServer).GetActiveExecutions(ctx, req.(*emptypb.Empty))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -405,6 +470,63 @@ func _Spiderjob_SetExecution_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Spiderjob_StreamExecution_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(StreamExecutionRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(SpiderjobServer).StreamExecution(m, &spiderjobStreamExecutionServer{stream})
+}
+
+type Spiderjob_StreamExecutionServer interface {
+	Send(*StreamExecutionResponse) error
+	grpc.ServerStream
+}
+
+type spiderjobStreamExecutionServer struct {
+	grpc.ServerStream
+}
+
+func (x *spiderjobStreamExecutionServer) Send(m *StreamExecutionResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Spiderjob_SetCalendar_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetCalendarRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SpiderjobServer).SetCalendar(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/types.Spiderjob/SetCalendar",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SpiderjobServer).SetCalendar(ctx, req.(*SetCalendarRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Spiderjob_DeleteCalendar_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteCalendarRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SpiderjobServer).DeleteCalendar(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/types.Spiderjob/DeleteCalendar",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SpiderjobServer).DeleteCalendar(ctx, req.(*DeleteCalendarRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Spiderjob_ServiceDesc is the grpc.ServiceDesc for Spiderjob service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -456,8 +578,22 @@ var Spiderjob_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SetExecution",
 			Handler:    _Spiderjob_SetExecution_Handler,
 		},
+		{
+			MethodName: "SetCalendar",
+			Handler:    _Spiderjob_SetCalendar_Handler,
+		},
+		{
+			MethodName: "DeleteCalendar",
+			Handler:    _Spiderjob_DeleteCalendar_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "StreamExecution",
+			Handler:       _Spiderjob_StreamExecution_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "spiderjob.proto",
 }
 
@@ -466,6 +602,9 @@ var Spiderjob_ServiceDesc = grpc.ServiceDesc{
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type AgentClient interface {
 	AgentRun(ctx context.Context, in *AgentRunRequest, opts ...grpc.CallOption) (Agent_AgentRunClient, error)
+	StopExecution(ctx context.Context, in *StopExecutionRequest, opts ...grpc.CallOption) (*StopExecutionResponse, error)
+	GetExecutionLog(ctx context.Context, in *GetExecutionLogRequest, opts ...grpc.CallOption) (*GetExecutionLogResponse, error)
+	DeleteExecutionLogs(ctx context.Context, in *DeleteExecutionLogsRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 }
 
 type agentClient struct {
@@ -508,11 +647,41 @@ func (x *agentAgentRunClient) Recv() (*AgentRunStream, error) {
 	return m, nil
 }
 
+func (c *agentClient) StopExecution(ctx context.Context, in *StopExecutionRequest, opts ...grpc.CallOption) (*StopExecutionResponse, error) {
+	out := new(StopExecutionResponse)
+	err := c.cc.Invoke(ctx, "/types.Agent/StopExecution", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *agentClient) GetExecutionLog(ctx context.Context, in *GetExecutionLogRequest, opts ...grpc.CallOption) (*GetExecutionLogResponse, error) {
+	out := new(GetExecutionLogResponse)
+	err := c.cc.Invoke(ctx, "/types.Agent/GetExecutionLog", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *agentClient) DeleteExecutionLogs(ctx context.Context, in *DeleteExecutionLogsRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/types.Agent/DeleteExecutionLogs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // AgentServer is the server API for Agent service.
 // All implementations must embed UnimplementedAgentServer
 // for forward compatibility
 type AgentServer interface {
 	AgentRun(*AgentRunRequest, Agent_AgentRunServer) error
+	StopExecution(context.Context, *StopExecutionRequest) (*StopExecutionResponse, error)
+	GetExecutionLog(context.Context, *GetExecutionLogRequest) (*GetExecutionLogResponse, error)
+	DeleteExecutionLogs(context.Context, *DeleteExecutionLogsRequest) (*emptypb.Empty, error)
 	mustEmbedUnimplementedAgentServer()
 }
 
@@ -523,6 +692,15 @@ type UnimplementedAgentServer struct {
 func (UnimplementedAgentServer) AgentRun(*AgentRunRequest, Agent_AgentRunServer) error {
 	return status.Errorf(codes.Unimplemented, "method AgentRun not implemented")
 }
+func (UnimplementedAgentServer) StopExecution(context.Context, *StopExecutionRequest) (*StopExecutionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StopExecution not implemented")
+}
+func (UnimplementedAgentServer) GetExecutionLog(context.Context, *GetExecutionLogRequest) (*GetExecutionLogResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetExecutionLog not implemented")
+}
+func (UnimplementedAgentServer) DeleteExecutionLogs(context.Context, *DeleteExecutionLogsRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteExecutionLogs not implemented")
+}
 func (UnimplementedAgentServer) mustEmbedUnimplementedAgentServer() {}
 
 // UnsafeAgentServer may be embedded to opt out of forward compatibility for this service.
@@ -557,13 +735,80 @@ func (x *agentAgentRunServer) Send(m *AgentRunStream) error {
 	return x.ServerStream.SendMsg(m)
 }
 
+func _Agent_StopExecution_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StopExecutionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AgentServer).StopExecution(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/types.Agent/StopExecution",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AgentServer).StopExecution(ctx, req.(*StopExecutionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Agent_GetExecutionLog_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetExecutionLogRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AgentServer).GetExecutionLog(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/types.Agent/GetExecutionLog",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AgentServer).GetExecutionLog(ctx, req.(*GetExecutionLogRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Agent_DeleteExecutionLogs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteExecutionLogsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AgentServer).DeleteExecutionLogs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/types.Agent/DeleteExecutionLogs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AgentServer).DeleteExecutionLogs(ctx, req.(*DeleteExecutionLogsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Agent_ServiceDesc is the grpc.ServiceDesc for Agent service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
 var Agent_ServiceDesc = grpc.ServiceDesc{
 	ServiceName: "types.Agent",
 	HandlerType: (*AgentServer)(nil),
-	Methods:     []grpc.MethodDesc{},
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "StopExecution",
+			Handler:    _Agent_StopExecution_Handler,
+		},
+		{
+			MethodName: "GetExecutionLog",
+			Handler:    _Agent_GetExecutionLog_Handler,
+		},
+		{
+			MethodName: "DeleteExecutionLogs",
+			Handler:    _Agent_DeleteExecutionLogs_Handler,
+		},
+	},
 	Streams: []grpc.StreamDesc{
 		{
 			StreamName:    "AgentRun",